@@ -4,18 +4,34 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"os"
 
 	iiapi "github.com/openshift/image-inspector/pkg/api"
 	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+	iidaemon "github.com/openshift/image-inspector/pkg/daemon"
 	ii "github.com/openshift/image-inspector/pkg/inspector"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "verify" {
+		verifyAttestation(os.Args[2:])
+		return
+	}
+
 	inspectorOptions := iicmd.NewDefaultImageInspectorOptions()
 
 	flag.StringVar(&inspectorOptions.URI, "docker", inspectorOptions.URI, "Daemon socket to connect to")
+	flag.StringVar(&inspectorOptions.Runtime, "runtime", inspectorOptions.Runtime, fmt.Sprintf("The container runtime the image is pulled with, one of: %v", iiapi.RuntimeOptions))
+	flag.StringVar(&inspectorOptions.RuntimeEndpoint, "runtime-endpoint", inspectorOptions.RuntimeEndpoint, "The socket of the podman or cri runtime (default: unix:///run/podman/podman.sock or unix:///run/containerd/containerd.sock)")
 	flag.StringVar(&inspectorOptions.Image, "image", inspectorOptions.Image, "Docker image to inspect")
+	flag.StringVar(&inspectorOptions.Container, "container", inspectorOptions.Container, "The id or name of a container to inspect, with the files it changed, instead of an image")
+	flag.StringVar(&inspectorOptions.Platform, "platform", inspectorOptions.Platform, "The os/arch[/variant] platform of the image to inspect, selected from its manifest list")
+	flag.BoolVar(&inspectorOptions.AllPlatforms, "all-platforms", inspectorOptions.AllPlatforms, "Inspect every platform of the manifest list of the image, each one with its own metadata")
+	flag.BoolVar(&inspectorOptions.AllowUnresolvedTag, "allow-unresolved-tag", inspectorOptions.AllowUnresolvedTag, "Pull the tags whose digest can't be resolved from their registry as they are instead of failing")
+	flag.StringVar(&inspectorOptions.SignaturePolicy, "signature-policy", inspectorOptions.SignaturePolicy, "The policy file of the keys trusted to sign the images, per registry or namespace, verified before extracting them")
+	flag.BoolVar(&inspectorOptions.RequireSignatures, "require-signatures", inspectorOptions.RequireSignatures, "Abort the inspection when the signatures of the image don't satisfy the signature policy")
 	flag.StringVar(&inspectorOptions.DstPath, "path", inspectorOptions.DstPath, "Destination path for the image files")
+	flag.BoolVar(&inspectorOptions.Keep, "keep", inspectorOptions.Keep, "Keep the extracted image and the scan results instead of removing them on exit")
 	flag.StringVar(&inspectorOptions.Serve, "serve", inspectorOptions.Serve, "Host and port where to serve the image with webdav")
 	flag.BoolVar(&inspectorOptions.Chroot, "chroot", inspectorOptions.Chroot, "Change root when serving the image with webdav")
 	flag.Var(&inspectorOptions.DockerCfg, "dockercfg", "Location of the docker configuration files. May be specified more than once")
@@ -25,6 +41,39 @@ func main() {
 	flag.StringVar(&inspectorOptions.ScanResultsDir, "scan-results-dir", inspectorOptions.ScanResultsDir, "The directory that will contain the results of the scan")
 	flag.BoolVar(&inspectorOptions.OpenScapHTML, "openscap-html-report", inspectorOptions.OpenScapHTML, "Generate an OpenScap HTML report in addition to the ARF formatted report")
 	flag.StringVar(&inspectorOptions.CVEUrlPath, "cve-url", inspectorOptions.CVEUrlPath, "An alternative URL source for CVE files")
+	flag.BoolVar(&inspectorOptions.ExtractLayers, "layers", inspectorOptions.ExtractLayers, "Extract the image layer by layer from its export and record which layer introduced each file, false exports a container created from it instead")
+	flag.Float64Var(&inspectorOptions.MinEfficiency, "min-efficiency", inspectorOptions.MinEfficiency, "Fail if the image efficiency score is lower than this value (between 0 and 1, requires layers)")
+	flag.StringVar(&inspectorOptions.AttestationKey, "attestation-key", inspectorOptions.AttestationKey, "Sign an in-toto attestation of the scan summary and the image digest with this PEM private key file (ECDSA, RSA or ed25519)")
+	flag.StringVar(&inspectorOptions.Output, "output", inspectorOptions.Output, fmt.Sprintf("Write a report of the inspection in one of the formats: %v", iiapi.OutputOptions))
+	flag.StringVar(&inspectorOptions.OutputFile, "output-file", inspectorOptions.OutputFile, "The file the report is written to instead of the standard output")
+
+	flag.StringVar(&inspectorOptions.StorePath, "store-path", inspectorOptions.StorePath, "The directory of the on-disk store where the results of the inspections are recorded")
+	flag.StringVar(&inspectorOptions.AuthTokenFile, "auth-token-file", inspectorOptions.AuthTokenFile, "A CSV file of bearer tokens (token,user,uid,\"group1,group2\") allowed to access the server")
+	flag.StringVar(&inspectorOptions.AuthHtpasswdFile, "auth-htpasswd-file", inspectorOptions.AuthHtpasswdFile, "An htpasswd file of the users allowed to access the server with basic auth")
+	flag.BoolVar(&inspectorOptions.AuthTokenReview, "auth-token-review", inspectorOptions.AuthTokenReview, "Allow the Kubernetes service account tokens validated with the TokenReview API to access the server")
+	flag.StringVar(&inspectorOptions.AuthTokenAudience, "auth-token-audience", inspectorOptions.AuthTokenAudience, "The audience the service account tokens validated with the TokenReview API must be issued for")
+	flag.Var(&inspectorOptions.AuthContentUsers, "auth-content-user", "A user (user:<name>) or group (group:<name>) allowed to access the image content, the others only access the metadata and the reports. May be specified more than once")
+	flag.StringVar(&inspectorOptions.TLSCert, "tls-cert", inspectorOptions.TLSCert, "Serve with TLS using this certificate file, reloaded when it changes")
+	flag.StringVar(&inspectorOptions.TLSKey, "tls-key", inspectorOptions.TLSKey, "The key file of the TLS certificate")
+	flag.StringVar(&inspectorOptions.TLSClientCA, "tls-client-ca", inspectorOptions.TLSClientCA, "Authenticate the clients presenting a TLS certificate signed by the CAs of this file")
+	flag.DurationVar(&inspectorOptions.ServeTimeout, "serve-timeout", inspectorOptions.ServeTimeout, "Stop serving and exit after this long, e.g. 1h (default: serve forever)")
+	flag.DurationVar(&inspectorOptions.IdleTimeout, "idle-timeout", inspectorOptions.IdleTimeout, "Stop serving and exit when no request is received for this long, e.g. 10m (default: never)")
+	flag.IntVar(&inspectorOptions.ExitAfterDownloads, "exit-after-downloads", inspectorOptions.ExitAfterDownloads, "Stop serving and exit once the scan report was downloaded this many times (default: never)")
+
+	flag.BoolVar(&inspectorOptions.Daemon, "daemon", inspectorOptions.Daemon, "Run a long-running inspection service accepting the images to inspect through the API")
+	flag.IntVar(&inspectorOptions.Workers, "workers", inspectorOptions.Workers, "The number of inspections the inspection service runs in parallel")
+	flag.IntVar(&inspectorOptions.QueueSize, "queue-size", inspectorOptions.QueueSize, "The number of inspections the inspection service keeps waiting before refusing new ones")
+	flag.IntVar(&inspectorOptions.InspectionRetention, "inspection-retention", inspectorOptions.InspectionRetention, "The number of finished inspections the inspection service keeps with their content, the older ones only keep their stored result")
+	flag.DurationVar(&inspectorOptions.InspectionTTL, "inspection-ttl", inspectorOptions.InspectionTTL, "How long the inspection service keeps the finished inspections with their content, 0 for forever")
+	flag.BoolVar(&inspectorOptions.Webhook, "webhook", inspectorOptions.Webhook, "Serve a validating admission webhook for pods from the inspection service (requires --tls-cert)")
+	flag.BoolVar(&inspectorOptions.WebhookFailOpen, "webhook-fail-open", inspectorOptions.WebhookFailOpen, "Admit the pods whose images can't be evaluated by the webhook, with a warning, instead of denying them")
+	flag.BoolVar(&inspectorOptions.Controller, "controller", inspectorOptions.Controller, "Discover and inspect the images run by the pods of the cluster the inspection service runs in")
+	flag.DurationVar(&inspectorOptions.ControllerInterval, "controller-interval", inspectorOptions.ControllerInterval, "The minimum time between two inspections submitted by the controller")
+	flag.StringVar(&inspectorOptions.WebhookDenySeverity, "webhook-deny-severity", inspectorOptions.WebhookDenySeverity, fmt.Sprintf("The lowest severity of the failed findings denying a pod, one of: %v", iiapi.SeverityOptions))
+	flag.StringVar(&inspectorOptions.Publish, "publish", inspectorOptions.Publish, fmt.Sprintf("Publish the summary of the inspections to Kubernetes, one of: %v", iiapi.PublishOptions))
+	flag.Var(&inspectorOptions.PublishPods, "publish-pod", "A pod, as namespace/name, annotated with the summary of the inspection with --publish=pod. May be specified more than once")
+	flag.StringVar(&inspectorOptions.PublishNamespace, "publish-namespace", inspectorOptions.PublishNamespace, "The namespace of the ImageScanResult resources published with --publish=resource")
+	flag.StringVar(&inspectorOptions.PublishURL, "publish-url", inspectorOptions.PublishURL, "The URL the server is reachable at, linking the published summaries to the results")
 
 	flag.Parse()
 
@@ -32,6 +81,15 @@ func main() {
 		log.Fatal(err)
 	}
 
+	ii.SweepStaleTempDirs(ii.TEMP_DIR_ROOT)
+
+	if inspectorOptions.Daemon {
+		if err := iidaemon.NewInspectionService(*inspectorOptions).Serve(); err != nil {
+			log.Fatalf("Error serving inspections: %v", err)
+		}
+		return
+	}
+
 	inspector := ii.NewDefaultImageInspector(*inspectorOptions)
 	if err := inspector.Inspect(); err != nil {
 		log.Fatalf("Error inspecting image: %v", err)
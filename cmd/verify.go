@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/openshift/image-inspector/pkg/attestation"
+)
+
+// verifyAttestation runs the verify subcommand: it checks the attestation of
+// an inspection is signed by a public key, and optionally that it is about
+// an image digest, and writes the attested predicate to the standard output.
+func verifyAttestation(args []string) {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	keyFile := flags.String("key", "", "The PEM public key file the attestation must be signed by")
+	attestationFile := flags.String("attestation", "-", "The attestation, or the JSON report carrying it, to verify (default: the standard input)")
+	imageDigest := flags.String("image-digest", "", "The digest of the image the attestation must be about")
+	flags.Parse(args)
+
+	if len(*keyFile) == 0 {
+		log.Fatal("Please specify the public key the attestation must be signed by")
+	}
+	var r io.Reader = os.Stdin
+	if *attestationFile != "-" {
+		file, err := os.Open(*attestationFile)
+		if err != nil {
+			log.Fatalf("Unable to open the attestation: %v", err)
+		}
+		defer file.Close()
+		r = file
+	}
+	envelope, err := attestation.ReadEnvelope(r)
+	if err != nil {
+		log.Fatal(err)
+	}
+	statement, err := attestation.Verify(envelope, *keyFile)
+	if err != nil {
+		log.Fatalf("Error verifying the attestation: %v", err)
+	}
+	if len(*imageDigest) > 0 && statement.Predicate.ImageDigest != *imageDigest {
+		log.Fatalf("Error verifying the attestation: it is about the image %s instead of %s",
+			statement.Predicate.ImageDigest, *imageDigest)
+	}
+	log.Printf("Verified the attestation of the inspection of %s@%s", statement.Subject[0].Name, statement.Predicate.ImageDigest)
+
+	body, err := json.MarshalIndent(statement.Predicate, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(body))
+}
@@ -51,11 +51,11 @@ EOF
   if [[ "${TRAVIS:-}" != "true" ]]; then
     local go_version
     go_version=($(go version))
-    if [[ "${go_version[2]}" < "go1.5" ]]; then
+    if [[ "${go_version[2]}" < "go1.22" ]]; then
       cat <<EOF
 
 Detected Go version: ${go_version[*]}.
-image-inspector builds require Go version 1.5 or greater.
+image-inspector builds require Go version 1.22 or greater.
 
 EOF
       exit 2
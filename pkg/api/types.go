@@ -1,8 +1,11 @@
 package api
 
 import (
-	docker "github.com/fsouza/go-dockerclient"
+	"encoding/json"
+	"strings"
 	"time"
+
+	docker "github.com/fsouza/go-dockerclient"
 )
 
 // OpenSCAPStatus is the status of openscap scan
@@ -27,14 +30,508 @@ func (osm *OpenSCAPMetadata) SetError(err error) {
 }
 
 var (
-	ScanOptions = []string{"openscap"}
+	ScanOptions   = []string{"openscap"}
+	OutputOptions = []string{"json", "yaml", "table", "sarif", "junit"}
+	// SeverityOptions are the severities of the findings, lowest first
+	SeverityOptions = []string{"low", "medium", "high", "critical"}
+	// PublishOptions are where the summaries of the inspections can be published
+	PublishOptions = []string{"pod", "image", "resource"}
+	// RuntimeOptions are the container runtimes the images can be pulled with
+	RuntimeOptions = []string{"docker", "podman", "cri"}
+)
+
+// FindingResult is the outcome of a single check done by a scanner
+type FindingResult string
+
+const (
+	ResultPass          FindingResult = "pass"
+	ResultFail          FindingResult = "fail"
+	ResultError         FindingResult = "error"
+	ResultUnknown       FindingResult = "unknown"
+	ResultNotApplicable FindingResult = "notapplicable"
+	ResultNotChecked    FindingResult = "notchecked"
+	ResultNotSelected   FindingResult = "notselected"
+	ResultInformational FindingResult = "informational"
+	ResultFixed         FindingResult = "fixed"
 )
 
+// Finding is the result of a single check done by a scanner on the inspected image
+type Finding struct {
+	Scanner    string        // Name of the scanner that did the check
+	ID         string        // Identifier of the checked rule
+	Title      string        // Title of the checked rule
+	Severity   string        // Severity of the checked rule
+	Result     FindingResult // Outcome of the check
+	References []string      // Identifiers related to the rule, e.g. CVEs
+	Path       string        // Path inside the image the finding refers to, if any
+	Layer      *ImageLayer   // Layer that introduced Path, if known
+	Time       string        // Time of the check
+}
+
 // InspectorMetadata is the metadata type with information about image-inspector's operation
 type InspectorMetadata struct {
 	docker.Image // Metadata about the inspected image
 	// OpenSCAP describes the state of the OpenSCAP scan
 	OpenSCAP *OpenSCAPMetadata
+	// Layers is the per-layer breakdown of the image, base layer first.
+	// It is only filled when the image was extracted layer by layer.
+	Layers []ImageLayer
+	// Efficiency describes how efficiently the image layers use space.
+	// It is only filled when the image was extracted layer by layer.
+	Efficiency *ImageEfficiency
+	// Container describes the inspected container, the image being the one
+	// it runs. It is only filled when inspecting a container.
+	Container *ContainerMetadata
+	// Platform is the platform of the image selected from its manifest list.
+	// It is only filled when a platform was requested.
+	Platform *Platform
+	// Provenance describes where the image was pulled from. It is only filled
+	// when inspecting an image by reference.
+	Provenance *ImageProvenance
+	// Signatures is the outcome of the verification of the signatures of the
+	// image. It is only filled when a signature policy is given.
+	Signatures *SignatureVerification
+}
+
+// SignatureVerification is the outcome of the verification of the
+// signatures of an image against the signature policy
+type SignatureVerification struct {
+	// Scope is the scope of the policy the image was checked against, empty
+	// for the default requirements
+	Scope string
+	// Verified is whether the image satisfies the requirements of the scope
+	Verified bool
+	// Signatures are the valid signatures of the image
+	Signatures []ImageSignature
+	// Error is why the image doesn't satisfy the requirements
+	Error string
+}
+
+// ImageSignature is a valid signature of an image
+type ImageSignature struct {
+	Type     string // Requirement the signature satisfies, signedBy or cosignSigned
+	Key      string // Fingerprint of the key that made the signature
+	Identity string // Reference the signature was made for
+}
+
+// ImageProvenance describes the manifest an image was pulled by
+type ImageProvenance struct {
+	Reference  string    // Reference the inspection was asked for
+	Registry   string    // Host of the registry of the image
+	RepoDigest string    // Reference by digest of the pulled manifest
+	MediaType  string    // Media type of the pulled manifest
+	PullTime   time.Time // When the image was pulled
+	// Unresolved is whether the tag was pulled without being resolved to a
+	// digest first, its RepoDigest is then the one the runtime reports
+	Unresolved bool
+	// Verified is whether the extracted image was checked to be the one of
+	// the pulled manifest
+	Verified bool
+}
+
+// Platform is the operating system and architecture an image is built for
+type Platform struct {
+	OS           string // Operating system, e.g. linux
+	Architecture string // Architecture, with the names of GOARCH, e.g. arm64
+	Variant      string // Variant of the architecture, e.g. v7 for arm
+}
+
+// String returns the os/arch[/variant] form of the platform.
+func (p Platform) String() string {
+	s := p.OS + "/" + p.Architecture
+	if len(p.Variant) > 0 {
+		s += "/" + p.Variant
+	}
+	return s
+}
+
+// ContainerChangeKind is how a path of a container was changed from its image
+type ContainerChangeKind string
+
+const (
+	ChangeModified ContainerChangeKind = "modified"
+	ChangeAdded    ContainerChangeKind = "added"
+	ChangeDeleted  ContainerChangeKind = "deleted"
+)
+
+// ContainerChange is a path of a container changed from its image, as
+// reported by docker diff
+type ContainerChange struct {
+	Path string
+	Kind ContainerChangeKind
+}
+
+// ContainerMetadata describes an inspected container
+type ContainerMetadata struct {
+	ID        string    // Id of the container
+	Name      string    // Name of the container
+	Image     string    // Reference of the image the container was created from
+	Created   time.Time // Creation time of the container
+	StartedAt time.Time // Last time the container was started
+	Status    string    // Status of the container, e.g. running or exited
+	Running   bool      // Whether the container was running when inspected
+	// Changes are the paths changed from the image, ordered by path
+	Changes []ContainerChange
+}
+
+// ImageLayer describes a single layer of the inspected image
+type ImageLayer struct {
+	Digest    string    // Digest of the uncompressed layer tar
+	CreatedBy string    // History command that created the layer
+	Created   time.Time // Creation time of the layer
+	Size      int64     // Bytes of file content added by the layer
+	Files     int       // Number of files added or modified by the layer
+	Deleted   int       // Number of files deleted by the layer
+}
+
+// ImageEfficiency describes how much of the image content is wasted by files
+// that are overwritten, deleted or duplicated
+type ImageEfficiency struct {
+	// Score is the fraction of TotalBytes that is not wasted, 1 being the best
+	Score float64
+	// TotalBytes is the file content added by all the layers
+	TotalBytes int64
+	// WastedBytes is the content overwritten or deleted by later layers plus
+	// the extra copies of duplicated files
+	WastedBytes int64
+	// LayerBytes are the bytes added by each layer, base layer first
+	LayerBytes []int64
+	// WastedFileCount is the number of paths overwritten or deleted by later layers
+	WastedFileCount int
+	// WastedFiles are the paths wasting the most space
+	WastedFiles []WastedFile
+	// DuplicateCount is the number of contents found at more than one path
+	DuplicateCount int
+	// Duplicates are the duplicated contents wasting the most space
+	Duplicates []DuplicateFiles
+	// LargestFiles are the largest files of the image
+	LargestFiles []PathSize
+	// LargestDirs are the directories with the largest content
+	LargestDirs []PathSize
+}
+
+// WastedFile is a path whose content is overwritten or deleted by later layers
+type WastedFile struct {
+	Path        string // Path inside the image
+	Occurrences int    // Number of layers adding the path
+	WastedBytes int64  // Bytes hidden by later layers
+	Deleted     bool   // Whether the path is deleted from the final image
+}
+
+// DuplicateFiles is a content found at more than one path of the image
+type DuplicateFiles struct {
+	Digest      string   // Digest of the content
+	Size        int64    // Size of a single copy
+	Paths       []string // Paths holding the content
+	WastedBytes int64    // Bytes used by the extra copies
+}
+
+// PathSize is the size of a file or of the content of a directory
+type PathSize struct {
+	Path string
+	Size int64
+}
+
+// LayerFiles maps the paths of the extracted image to the index in
+// InspectorMetadata.Layers of the layer that last added or modified them.
+type LayerFiles map[string]int
+
+// LayerOf returns the layer that introduced path, or nil if it is unknown.
+func (m *InspectorMetadata) LayerOf(files LayerFiles, path string) *ImageLayer {
+	idx, ok := files[path]
+	if !ok || idx < 0 || idx >= len(m.Layers) {
+		return nil
+	}
+	return &m.Layers[idx]
+}
+
+// RepoDigest returns the repository and the digest of the manifest of the
+// inspected image, the one its provenance was pinned to or else the first
+// one of its repo digests, both empty when the image was never pushed.
+func (m *InspectorMetadata) RepoDigest() (string, string) {
+	repoDigests := m.RepoDigests
+	if m.Provenance != nil && len(m.Provenance.RepoDigest) > 0 {
+		repoDigests = append([]string{m.Provenance.RepoDigest}, repoDigests...)
+	}
+	for _, ref := range repoDigests {
+		if at := strings.Index(ref, "@"); at >= 0 && strings.Contains(ref[at+1:], ":") {
+			return ref[:at], ref[at+1:]
+		}
+	}
+	return "", ""
+}
+
+// InspectorReport is the complete outcome of an inspection
+type InspectorReport struct {
+	// Image is the reference of the inspected image
+	Image string
+	// Metadata is the metadata of the inspection
+	Metadata *InspectorMetadata
+	// Findings are the results of the checks done by the scanners
+	Findings []Finding
+	// Platforms are the reports of every platform of the image when all of
+	// them are inspected, Metadata and Findings being empty then
+	Platforms []InspectorReport `json:",omitempty"`
+	// SBOM is the CycloneDX software bill of materials of the image, listing
+	// the packages of its package databases
+	SBOM json.RawMessage `json:",omitempty"`
+	// Attestation is the signed attestation of the inspection, when one
+	// was requested
+	Attestation *Envelope `json:",omitempty"`
+}
+
+// Envelope is a DSSE envelope carrying a signed payload
+type Envelope struct {
+	// PayloadType is the media type of the payload
+	PayloadType string `json:"payloadType"`
+	// Payload is the base64 encoded payload
+	Payload string `json:"payload"`
+	// Signatures are the signatures of the payload
+	Signatures []EnvelopeSignature `json:"signatures"`
+}
+
+// EnvelopeSignature is a signature of the payload of a DSSE envelope
+type EnvelopeSignature struct {
+	// KeyID is the SHA256 fingerprint of the public key of the signature
+	KeyID string `json:"keyid"`
+	// Sig is the base64 encoded signature
+	Sig string `json:"sig"`
+}
+
+// Statement is an in-toto statement of the inspection of an image, the
+// payload of its attestation
+type Statement struct {
+	Type          string             `json:"_type"`
+	Subject       []StatementSubject `json:"subject"`
+	PredicateType string             `json:"predicateType"`
+	Predicate     ScanPredicate      `json:"predicate"`
+}
+
+// StatementSubject is an artifact an in-toto statement is about
+type StatementSubject struct {
+	// Name is the repository of the image
+	Name string `json:"name"`
+	// Digest is the digest of the manifest of the image by algorithm
+	Digest map[string]string `json:"digest"`
+}
+
+// ScanPredicate is what an attestation states about the inspected image
+type ScanPredicate struct {
+	// ImageDigest is the digest of the manifest of the image
+	ImageDigest string `json:"imageDigest"`
+	// SBOMDigest is the digest of the software bill of materials of the
+	// image, if one was generated
+	SBOMDigest string `json:"sbomDigest,omitempty"`
+	// Summary is the summary of the scan of the image
+	Summary ScanSummary `json:"summary"`
+}
+
+// InspectionStatus is the status of an inspection submitted to the inspection service
+type InspectionStatus string
+
+const (
+	InspectionQueued    InspectionStatus = "Queued"
+	InspectionRunning   InspectionStatus = "Running"
+	InspectionSucceeded InspectionStatus = "Succeeded"
+	InspectionFailed    InspectionStatus = "Failed"
+)
+
+// InspectionRequest is a request to inspect an image sent to the inspection service
+type InspectionRequest struct {
+	// Image is the image to inspect
+	Image string `json:"image"`
+	// ScanTypes are the scans to run on the image
+	ScanTypes []string `json:"scanTypes"`
+}
+
+// Inspection is an inspection job of the inspection service
+type Inspection struct {
+	// ID identifies the inspection
+	ID string `json:"id"`
+	// Image is the inspected image
+	Image string `json:"image"`
+	// ScanTypes are the scans run on the image
+	ScanTypes []string `json:"scanTypes"`
+	// Status is the status of the inspection
+	Status InspectionStatus `json:"status"`
+	// ErrorMessage is the reason of a failed inspection
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	// Submitted is when the inspection was requested
+	Submitted time.Time `json:"submitted"`
+	// Started is when the inspection started running
+	Started *time.Time `json:"started,omitempty"`
+	// Finished is when the inspection ended
+	Finished *time.Time `json:"finished,omitempty"`
+	// ResultID identifies the stored result of the inspection
+	ResultID string `json:"resultId,omitempty"`
+	// Reused is true when the result of a previous scan of the same digest was reused
+	Reused bool `json:"reused,omitempty"`
+}
+
+// DiscoveredImage is an image run by the pods of the cluster, found by the
+// controller of the inspection service
+type DiscoveredImage struct {
+	// Image is the reference of the inspected image, by digest when known
+	Image string `json:"image"`
+	// ImageID is the id of the image reported by the container runtime
+	ImageID string `json:"imageID"`
+	// Pods are the pods running the image, as namespace/name
+	Pods []string `json:"pods"`
+	// Inspection is the inspection of the image, nil until it's submitted
+	Inspection *Inspection `json:"inspection,omitempty"`
+}
+
+// ScanResult is the outcome of an inspection as kept by the results store
+type ScanResult struct {
+	// ID identifies the result
+	ID string
+	// ImageDigest is the digest of the manifest of the inspected image the
+	// result is keyed by
+	ImageDigest string
+	// ImageID is the id of the inspected image, the digest of its configuration
+	ImageID string
+	// Image is the reference the inspection was requested for
+	Image string
+	// ScanTypes are the scans done on the image
+	ScanTypes []string
+	// Started is when the inspection started
+	Started time.Time
+	// Finished is when the inspection ended
+	Finished time.Time
+	// Metadata is the metadata of the inspection
+	Metadata *InspectorMetadata
+	// Findings are the results of the checks done by the scanners
+	Findings []Finding
+	// SBOM is the software bill of materials of the image, if one was generated
+	SBOM json.RawMessage `json:",omitempty"`
+	// Attestation is the signed attestation of the inspection, if any
+	Attestation *Envelope `json:",omitempty"`
+}
+
+// Report returns the result as an inspection report.
+func (r *ScanResult) Report() *InspectorReport {
+	return &InspectorReport{
+		Image:       r.Image,
+		Metadata:    r.Metadata,
+		Findings:    r.Findings,
+		SBOM:        r.SBOM,
+		Attestation: r.Attestation,
+	}
+}
+
+// ScanSummary is the summary of an inspection published to Kubernetes
+type ScanSummary struct {
+	// Image is the reference of the inspected image
+	Image string `json:"image"`
+	// ImageDigest is the digest of the manifest of the image, when known
+	ImageDigest string `json:"imageDigest,omitempty"`
+	// ImageID is the id of the image
+	ImageID string `json:"imageID"`
+	// Vulnerabilities are the numbers of failed findings by severity
+	Vulnerabilities map[string]int `json:"vulnerabilities"`
+	// OpenSCAPStatus is the status of the OpenSCAP scan
+	OpenSCAPStatus OpenSCAPStatus `json:"openscapStatus"`
+	// ScanTime is when the inspection ended
+	ScanTime time.Time `json:"scanTime"`
+	// ResultsURL is where the results of the inspection are served, if known
+	ResultsURL string `json:"resultsURL,omitempty"`
+}
+
+// ProgressStage is the stage of the inspection served by the image server
+type ProgressStage string
+
+const (
+	ProgressPulling    ProgressStage = "pulling"
+	ProgressExtracting ProgressStage = "extracting"
+	ProgressScanning   ProgressStage = "scanning"
+	ProgressReady      ProgressStage = "ready"
+	ProgressFailed     ProgressStage = "failed"
+)
+
+// InspectionProgress is the progress of the inspection served by the image server
+type InspectionProgress struct {
+	// Image is the reference of the inspected image
+	Image string `json:"image"`
+	// Stage is the current stage of the inspection
+	Stage ProgressStage `json:"stage"`
+	// Layers is the progress of the download of each layer, by layer id
+	Layers map[string]LayerProgress `json:"layers"`
+	// FilesExtracted is the number of files extracted so far
+	FilesExtracted int `json:"filesExtracted"`
+	// ErrorMessage tells why the inspection failed
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	// Updated is the time of the last change
+	Updated time.Time `json:"updated"`
+}
+
+// Done returns true once the inspection is ready or failed.
+func (p InspectionProgress) Done() bool {
+	return p.Stage == ProgressReady || p.Stage == ProgressFailed
+}
+
+// LayerProgress is the progress of the download of a layer
+type LayerProgress struct {
+	// Downloaded and Total are the bytes downloaded and to download
+	Downloaded int64 `json:"downloaded"`
+	Total      int64 `json:"total"`
+}
+
+// FileType is the type of a file of the image content
+type FileType string
+
+const (
+	FileTypeRegular   FileType = "file"
+	FileTypeDirectory FileType = "directory"
+	FileTypeSymlink   FileType = "symlink"
+	FileTypeOther     FileType = "other"
+)
+
+// FileInfo describes an entry of a directory of the image content
+type FileInfo struct {
+	// Name is the name of the entry in its directory
+	Name string `json:"name"`
+	// Size is the size in bytes of the entry
+	Size int64 `json:"size"`
+	// Mode holds the permission bits, in octal
+	Mode string `json:"mode"`
+	// Type is the type of the entry
+	Type FileType `json:"type"`
+	// LinkTarget is the target of a symlink
+	LinkTarget string `json:"linkTarget,omitempty"`
+}
+
+// SearchResult is a page of the matches of a search of the image content
+type SearchResult struct {
+	// Matches are the matching paths, in the order of a depth-first walk
+	Matches []SearchMatch `json:"matches"`
+	// Next is the offset of the next page, 0 when this is the last page
+	Next int `json:"next,omitempty"`
+	// FilesScanned is the number of entries walked
+	FilesScanned int `json:"filesScanned"`
+	// Incomplete tells why the search stopped before walking the whole tree
+	Incomplete string `json:"incomplete,omitempty"`
+}
+
+// SearchMatch is a path matching a search
+type SearchMatch struct {
+	// Path is the absolute path inside the image
+	Path string `json:"path"`
+	// Size is the size in bytes of the entry
+	Size int64 `json:"size"`
+	// Type is the type of the entry
+	Type FileType `json:"type"`
+	// Lines are the first lines matching the content search
+	Lines []SearchLine `json:"lines,omitempty"`
+	// Binary is true when the content search matched a binary file
+	Binary bool `json:"binary,omitempty"`
+}
+
+// SearchLine is a line matching a content search
+type SearchLine struct {
+	// Number is the number of the line, starting from 1
+	Number int `json:"number"`
+	// Text is the line, shortened when too long
+	Text string `json:"text"`
 }
 
 // APIVersions holds a slice of supported API versions.
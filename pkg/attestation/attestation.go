@@ -0,0 +1,223 @@
+package attestation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/publish"
+	"github.com/openshift/image-inspector/pkg/signature"
+)
+
+const (
+	// PAYLOAD_TYPE is the DSSE payload type of the in-toto statements
+	PAYLOAD_TYPE = "application/vnd.in-toto+json"
+	// STATEMENT_TYPE is the type of the in-toto statements
+	STATEMENT_TYPE = "https://in-toto.io/Statement/v0.1"
+	// PREDICATE_TYPE is the type of the predicate of the attestations of
+	// the inspections
+	PREDICATE_TYPE = "https://github.com/openshift/image-inspector/scan/v1"
+	// DSSE_VERSION prefixes the pre-authentication encoding of the payloads
+	DSSE_VERSION = "DSSEv1"
+	// MAX_ATTESTATION_SIZE is the maximum size of an attestation, or of the
+	// report carrying it, read by ReadEnvelope
+	MAX_ATTESTATION_SIZE = 64 * 1024 * 1024
+)
+
+// Signer signs the attestations of the inspections with a private key.
+type Signer struct {
+	key   crypto.Signer
+	keyID string
+}
+
+// LoadSigner reads the PEM private key of keyFile, an ECDSA, RSA or ed25519
+// key in PKCS #8, SEC 1 or PKCS #1 form.
+func LoadSigner(keyFile string) (*Signer, error) {
+	content, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read the attestation key: %v", err)
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("%s is not a PEM private key", keyFile)
+	}
+	var key interface{}
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%s is a %s instead of an unencrypted private key", keyFile, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse the attestation key %s: %v", keyFile, err)
+	}
+	var signer crypto.Signer
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		signer = k
+	case *rsa.PrivateKey:
+		signer = k
+	case ed25519.PrivateKey:
+		signer = k
+	default:
+		return nil, fmt.Errorf("Unsupported attestation key type %T", key)
+	}
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return nil, fmt.Errorf("Unable to marshal the public key of %s: %v", keyFile, err)
+	}
+	sum := sha256.Sum256(der)
+	return &Signer{key: signer, keyID: "SHA256:" + hex.EncodeToString(sum[:])}, nil
+}
+
+// KeyID returns the SHA256 fingerprint of the public key of the signer, the
+// one of the public keys read by signature.LoadPublicKey.
+func (s *Signer) KeyID() string {
+	return s.keyID
+}
+
+// Sign returns the DSSE envelope of statement signed by s.
+func (s *Signer) Sign(statement *iiapi.Statement) (*iiapi.Envelope, error) {
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to marshal the statement: %v", err)
+	}
+	message := pae(PAYLOAD_TYPE, payload)
+	var sig []byte
+	if _, ok := s.key.(ed25519.PrivateKey); ok {
+		sig, err = s.key.Sign(rand.Reader, message, crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256(message)
+		sig, err = s.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Unable to sign the statement: %v", err)
+	}
+	return &iiapi.Envelope{
+		PayloadType: PAYLOAD_TYPE,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []iiapi.EnvelopeSignature{{KeyID: s.keyID, Sig: base64.StdEncoding.EncodeToString(sig)}},
+	}, nil
+}
+
+// NewStatement returns the statement of the inspection of report, ended at
+// scanTime, about the manifest of the inspected image, with the digest of
+// its software bill of materials when one was generated.
+func NewStatement(report *iiapi.InspectorReport, scanTime time.Time) (*iiapi.Statement, error) {
+	var name, digest string
+	if report.Metadata != nil {
+		name, digest = report.Metadata.RepoDigest()
+	}
+	if len(digest) == 0 {
+		return nil, fmt.Errorf("The digest of %s is unknown", report.Image)
+	}
+	summary := publish.NewScanSummary(report, scanTime, "")
+	predicate := iiapi.ScanPredicate{ImageDigest: digest, Summary: *summary}
+	if report.SBOM != nil {
+		sum := sha256.Sum256(report.SBOM)
+		predicate.SBOMDigest = "sha256:" + hex.EncodeToString(sum[:])
+	}
+	algorithm := strings.SplitN(digest, ":", 2)
+	return &iiapi.Statement{
+		Type:          STATEMENT_TYPE,
+		Subject:       []iiapi.StatementSubject{{Name: name, Digest: map[string]string{algorithm[0]: algorithm[1]}}},
+		PredicateType: PREDICATE_TYPE,
+		Predicate:     predicate,
+	}, nil
+}
+
+// Verify checks envelope is signed by the public key of keyFile and returns
+// the statement it carries.
+func Verify(envelope *iiapi.Envelope, keyFile string) (*iiapi.Statement, error) {
+	key, keyID, err := signature.LoadPublicKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	if envelope.PayloadType != PAYLOAD_TYPE {
+		return nil, fmt.Errorf("The attestation carries a %s instead of a %s", envelope.PayloadType, PAYLOAD_TYPE)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("The payload of the attestation is not base64: %v", err)
+	}
+	message := pae(envelope.PayloadType, payload)
+	verified := false
+	for _, sig := range envelope.Signatures {
+		if len(sig.KeyID) > 0 && sig.KeyID != keyID {
+			continue
+		}
+		raw, err := base64.StdEncoding.DecodeString(sig.Sig)
+		if err != nil {
+			continue
+		}
+		if signature.VerifyBlob(key, message, raw) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, fmt.Errorf("The attestation is not signed by %s (%s)", keyFile, keyID)
+	}
+
+	statement := &iiapi.Statement{}
+	if err := json.Unmarshal(payload, statement); err != nil {
+		return nil, fmt.Errorf("Unable to parse the statement of the attestation: %v", err)
+	}
+	if statement.Type != STATEMENT_TYPE || statement.PredicateType != PREDICATE_TYPE {
+		return nil, fmt.Errorf("The attestation is a %s of %s instead of a %s of %s",
+			statement.Type, statement.PredicateType, STATEMENT_TYPE, PREDICATE_TYPE)
+	}
+	if len(statement.Subject) != 1 || len(statement.Predicate.ImageDigest) == 0 {
+		return nil, fmt.Errorf("The attestation is not about a single image")
+	}
+	algorithm := strings.SplitN(statement.Predicate.ImageDigest, ":", 2)
+	if len(algorithm) != 2 || statement.Subject[0].Digest[algorithm[0]] != algorithm[1] {
+		return nil, fmt.Errorf("The subject of the attestation is not the image %s", statement.Predicate.ImageDigest)
+	}
+	return statement, nil
+}
+
+// ReadEnvelope reads an attestation from r, either the envelope itself, as
+// served by the API, or the JSON report of the inspection carrying it.
+func ReadEnvelope(r io.Reader) (*iiapi.Envelope, error) {
+	content, err := ioutil.ReadAll(io.LimitReader(r, MAX_ATTESTATION_SIZE))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read the attestation: %v", err)
+	}
+	envelope := &iiapi.Envelope{}
+	if err := json.Unmarshal(content, envelope); err != nil {
+		return nil, fmt.Errorf("Unable to parse the attestation: %v", err)
+	}
+	if len(envelope.PayloadType) > 0 {
+		return envelope, nil
+	}
+	report := &iiapi.InspectorReport{}
+	if err := json.Unmarshal(content, report); err != nil || report.Attestation == nil {
+		return nil, fmt.Errorf("Neither an attestation nor the report of an attested inspection")
+	}
+	return report.Attestation, nil
+}
+
+// pae returns the DSSE pre-authentication encoding of payload, the message
+// actually signed.
+func pae(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("%s %d %s %d %s", DSSE_VERSION, len(payloadType), payloadType, len(payload), payload))
+}
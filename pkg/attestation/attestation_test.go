@@ -0,0 +1,175 @@
+package attestation
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+// writeKeys writes the private key and the public key of key in dir,
+// returning their files.
+func writeKeys(t *testing.T, dir, name string, key crypto.Signer) (string, string) {
+	var block *pem.Block
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			t.Fatalf("Unable to marshal the key: %v", err)
+		}
+		block = &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+	case *rsa.PrivateKey:
+		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}
+	default:
+		der, err := x509.MarshalPKCS8PrivateKey(k)
+		if err != nil {
+			t.Fatalf("Unable to marshal the key: %v", err)
+		}
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	}
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatalf("Unable to marshal the public key: %v", err)
+	}
+	keyFile, pubFile := path.Join(dir, name+".key"), path.Join(dir, name+".pub")
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(block), 0600); err != nil {
+		t.Fatalf("Unable to write the key: %v", err)
+	}
+	if err := ioutil.WriteFile(pubFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644); err != nil {
+		t.Fatalf("Unable to write the public key: %v", err)
+	}
+	return keyFile, pubFile
+}
+
+func testReport() *iiapi.InspectorReport {
+	return &iiapi.InspectorReport{
+		Image: "docker.io/library/fedora@sha256:1234",
+		Metadata: &iiapi.InspectorMetadata{
+			Image:      docker.Image{ID: "sha256:5678", RepoDigests: []string{"fedora@sha256:0000"}},
+			OpenSCAP:   &iiapi.OpenSCAPMetadata{Status: iiapi.StatusSuccess},
+			Provenance: &iiapi.ImageProvenance{RepoDigest: "docker.io/library/fedora@sha256:1234"},
+		},
+		Findings: []iiapi.Finding{
+			{ID: "CVE-2017-1000", Severity: "High", Result: iiapi.ResultFail},
+			{ID: "CVE-2017-2000", Severity: "Low", Result: iiapi.ResultPass},
+		},
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	dir, err := ioutil.TempDir("", "attestation-")
+	if err != nil {
+		t.Fatalf("Unable to create the temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	_, otherPub := writeKeys(t, dir, "other", otherKey)
+
+	report := testReport()
+	report.SBOM = []byte(`{"bomFormat":"CycloneDX","components":[]}`)
+	statement, err := NewStatement(report, time.Now())
+	if err != nil {
+		t.Fatalf("Unable to create the statement: %v", err)
+	}
+	sum := sha256.Sum256(report.SBOM)
+	if statement.Subject[0].Name != "docker.io/library/fedora" || statement.Subject[0].Digest["sha256"] != "1234" ||
+		statement.Predicate.Summary.ImageDigest != "sha256:1234" || statement.Predicate.Summary.Vulnerabilities["high"] != 1 ||
+		statement.Predicate.SBOMDigest != "sha256:"+hex.EncodeToString(sum[:]) {
+		t.Errorf("Unexpected statement %+v", statement)
+	}
+
+	for name, key := range map[string]crypto.Signer{"ecdsa": ecKey, "rsa": rsaKey, "ed25519": edKey} {
+		keyFile, pubFile := writeKeys(t, dir, name, key)
+		signer, err := LoadSigner(keyFile)
+		if err != nil {
+			t.Errorf("%s: unable to load the key: %v", name, err)
+			continue
+		}
+		envelope, err := signer.Sign(statement)
+		if err != nil {
+			t.Errorf("%s: unable to sign: %v", name, err)
+			continue
+		}
+		if envelope.PayloadType != PAYLOAD_TYPE || len(envelope.Signatures) != 1 || envelope.Signatures[0].KeyID != signer.KeyID() {
+			t.Errorf("%s: unexpected envelope %+v", name, envelope)
+		}
+		verified, err := Verify(envelope, pubFile)
+		if err != nil {
+			t.Errorf("%s: unable to verify the attestation: %v", name, err)
+		} else if verified.Predicate.ImageDigest != "sha256:1234" {
+			t.Errorf("%s: unexpected verified statement %+v", name, verified)
+		}
+		if _, err := Verify(envelope, otherPub); err == nil {
+			t.Errorf("%s: the attestation should not be verified by another key", name)
+		}
+
+		tampered := *envelope
+		tampered.Payload = base64.StdEncoding.EncodeToString([]byte(`{"_type": "https://in-toto.io/Statement/v0.1"}`))
+		if _, err := Verify(&tampered, pubFile); err == nil {
+			t.Errorf("%s: a tampered attestation should not be verified", name)
+		}
+	}
+
+	if _, err := LoadSigner(otherPub); err == nil {
+		t.Errorf("Loading a public key as the attestation key should have failed")
+	}
+}
+
+func TestNewStatementWithoutDigest(t *testing.T) {
+	report := testReport()
+	report.Metadata.Provenance = nil
+	statement, err := NewStatement(report, time.Now())
+	if err != nil || statement.Predicate.ImageDigest != "sha256:0000" || statement.Subject[0].Name != "fedora" ||
+		len(statement.Predicate.SBOMDigest) > 0 {
+		t.Errorf("Expected the digest of the runtime but got %+v: %v", statement, err)
+	}
+	report.Metadata.RepoDigests = nil
+	if _, err := NewStatement(report, time.Now()); err == nil {
+		t.Errorf("An image without digest can't be attested")
+	}
+}
+
+func TestReadEnvelope(t *testing.T) {
+	for content, shouldRead := range map[string]bool{
+		`{"payloadType": "application/vnd.in-toto+json", "payload": "e30=", "signatures": []}`: true,
+		`{"Image": "fedora", "Attestation": {"payloadType": "application/vnd.in-toto+json"}}`:  true,
+		`{"Image": "fedora", "Metadata": {}, "Findings": []}`:                                  false,
+		`not an attestation`: false,
+	} {
+		envelope, err := ReadEnvelope(strings.NewReader(content))
+		if shouldRead != (err == nil) {
+			t.Errorf("Reading %s: unexpected error %v", content, err)
+			continue
+		}
+		if err == nil && envelope.PayloadType != PAYLOAD_TYPE {
+			t.Errorf("Reading %s: unexpected envelope %+v", content, envelope)
+		}
+	}
+}
+
+func TestPAE(t *testing.T) {
+	if message := string(pae("http://example.com/HelloWorld", []byte("hello world"))); message != "DSSEv1 29 http://example.com/HelloWorld 11 hello world" {
+		t.Errorf("Unexpected pre-authentication encoding %q", message)
+	}
+}
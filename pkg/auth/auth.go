@@ -0,0 +1,214 @@
+package auth
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// Access is the level of access an endpoint requires.
+type Access int
+
+const (
+	// AccessPublic endpoints are served without authentication, e.g. healthz
+	AccessPublic Access = iota
+	// AccessMetadata endpoints serve the metadata and the reports of the inspection
+	AccessMetadata
+	// AccessContent endpoints serve the content of the image
+	AccessContent
+)
+
+const REALM = "image-inspector"
+
+const (
+	// USER_PREFIX marks the user names in the content users
+	USER_PREFIX = "user:"
+	// GROUP_PREFIX marks the group names in the content users
+	GROUP_PREFIX = "group:"
+)
+
+// User is an authenticated user.
+type User struct {
+	Name   string
+	Groups []string
+}
+
+// Authenticator authenticates the user of a request.
+type Authenticator interface {
+	// AuthenticateRequest returns the user of r or nil when r has no
+	// credentials handled by the authenticator. An error is returned for
+	// credentials that are handled but invalid.
+	AuthenticateRequest(r *http.Request) (*User, error)
+	// Challenge is the WWW-Authenticate challenge of the authenticator, if any.
+	Challenge() string
+}
+
+// Authorizer decides whether an authenticated user has a level of access.
+type Authorizer interface {
+	Authorize(user *User, access Access) bool
+}
+
+// Config configures the authentication and the authorization of a server.
+type Config struct {
+	// TokenFile is a CSV file of static bearer tokens
+	TokenFile string
+	// HtpasswdFile is an htpasswd file of basic auth users
+	HtpasswdFile string
+	// TokenReviewer validates the Kubernetes service account tokens
+	TokenReviewer TokenReviewer
+	// ClientCertificates authenticates the clients presenting a verified
+	// TLS certificate
+	ClientCertificates bool
+	// ContentUsers are the users and groups with access to the image
+	// content, all the authenticated users when empty
+	ContentUsers []string
+}
+
+// Filter authenticates and authorizes the requests to the endpoints of a
+// server.
+type Filter struct {
+	authenticators []Authenticator
+	authorizer     Authorizer
+}
+
+// NewFilter returns the filter configured by config, nil when config
+// enables no authentication.
+func NewFilter(config Config) (*Filter, error) {
+	f := &Filter{authorizer: NewContentUsersAuthorizer(config.ContentUsers)}
+	if config.ClientCertificates {
+		f.authenticators = append(f.authenticators, NewClientCertAuthenticator())
+	}
+	if len(config.TokenFile) > 0 {
+		authenticator, err := NewTokenFileAuthenticator(config.TokenFile)
+		if err != nil {
+			return nil, err
+		}
+		f.authenticators = append(f.authenticators, authenticator)
+	}
+	if config.TokenReviewer != nil {
+		f.authenticators = append(f.authenticators, NewTokenReviewAuthenticator(config.TokenReviewer))
+	}
+	if len(config.HtpasswdFile) > 0 {
+		authenticator, err := NewHtpasswdAuthenticator(config.HtpasswdFile)
+		if err != nil {
+			return nil, err
+		}
+		f.authenticators = append(f.authenticators, authenticator)
+	}
+	if len(f.authenticators) == 0 {
+		return nil, nil
+	}
+	return f, nil
+}
+
+// Require returns a handler serving the requests with handler only if they
+// have the given access. A nil filter lets all the requests through.
+func (f *Filter) Require(access Access, handler http.Handler) http.Handler {
+	if f == nil || access == AccessPublic {
+		return handler
+	}
+	return f.RequireFor(func(*http.Request) Access { return access }, handler)
+}
+
+// RequireFor is Require for handlers whose requests need different access,
+// as returned by access.
+func (f *Filter) RequireFor(access func(*http.Request) Access, handler http.Handler) http.Handler {
+	if f == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		required := access(r)
+		if required == AccessPublic {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		user, err := f.authenticate(r)
+		if err != nil {
+			log.Printf("Refusing request to %s: %v", r.URL.Path, err)
+		}
+		if user == nil {
+			for _, authenticator := range f.authenticators {
+				if challenge := authenticator.Challenge(); len(challenge) > 0 {
+					w.Header().Add("WWW-Authenticate", challenge)
+				}
+			}
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if !f.authorizer.Authorize(user, required) {
+			http.Error(w, fmt.Sprintf("%s is not allowed to access %s", user.Name, r.URL.Path), http.StatusForbidden)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// RequireFunc is Require for handler functions.
+func (f *Filter) RequireFunc(access Access, handler func(http.ResponseWriter, *http.Request)) http.Handler {
+	return f.Require(access, http.HandlerFunc(handler))
+}
+
+// authenticate returns the user authenticated by the first authenticator
+// handling the credentials of r.
+func (f *Filter) authenticate(r *http.Request) (*User, error) {
+	for _, authenticator := range f.authenticators {
+		user, err := authenticator.AuthenticateRequest(r)
+		if err != nil || user != nil {
+			return user, err
+		}
+	}
+	return nil, nil
+}
+
+// bearerToken returns the bearer token of r, if any.
+func bearerToken(r *http.Request) string {
+	header := r.Header.Get("Authorization")
+	if len(header) < 7 || !strings.EqualFold(header[:7], "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(header[7:])
+}
+
+// contentUsersAuthorizer gives metadata access to all the authenticated
+// users and content access to the users in users or in one of groups. User
+// and group names are apart, a user named like a group isn't a member.
+type contentUsersAuthorizer struct {
+	restricted bool
+	users      map[string]bool
+	groups     map[string]bool
+}
+
+// NewContentUsersAuthorizer returns an Authorizer giving content access to
+// the given users, as user:<name>, and groups, as group:<name>, or to
+// everybody when there are none. The entries without prefix are users.
+func NewContentUsersAuthorizer(contentUsers []string) Authorizer {
+	a := &contentUsersAuthorizer{
+		restricted: len(contentUsers) > 0,
+		users:      map[string]bool{},
+		groups:     map[string]bool{},
+	}
+	for _, entry := range contentUsers {
+		if strings.HasPrefix(entry, GROUP_PREFIX) {
+			a.groups[strings.TrimPrefix(entry, GROUP_PREFIX)] = true
+		} else {
+			a.users[strings.TrimPrefix(entry, USER_PREFIX)] = true
+		}
+	}
+	return a
+}
+
+func (a *contentUsersAuthorizer) Authorize(user *User, access Access) bool {
+	if access != AccessContent || !a.restricted {
+		return true
+	}
+	if a.users[user.Name] {
+		return true
+	}
+	for _, group := range user.Groups {
+		if a.groups[group] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,206 @@
+package auth
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+type fakeTokenReviewer map[string]*TokenReviewStatus
+
+func (r fakeTokenReviewer) Review(token string) (*TokenReviewStatus, error) {
+	if token == "broken" {
+		return nil, fmt.Errorf("FAIL!")
+	}
+	if status, ok := r[token]; ok {
+		return status, nil
+	}
+	return &TokenReviewStatus{Error: "unknown token"}, nil
+}
+
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	filename := path.Join(dir, name)
+	if err := ioutil.WriteFile(filename, []byte(content), 0600); err != nil {
+		t.Fatalf("Unable to write %s: %v", name, err)
+	}
+	return filename
+}
+
+func TestCheckPassword(t *testing.T) {
+	for hash, expected := range map[string]bool{
+		// generated with openssl passwd -apr1 -salt saltsalt secret
+		"$apr1$saltsalt$LrttParrLPdxvgutaSXWJ0": true,
+		"$apr1$saltsalt$LrttParrLPdxvgutaSXWJ1": false,
+		"$apr1$othersal$LrttParrLPdxvgutaSXWJ0": false,
+		"{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=":     true,
+		"{SHA}5en6G6MezRroT3XKqkdPOmY/BfR=":     false,
+		// bcrypt with cost 5, htpasswd -B writes the $2y$ prefix
+		"$2y$05$d5aXG3Qg9PkJaAE4SciCyOeqiAzuCueRkrIAT1YOsaSZgvOfyOn5m": true,
+		"$2a$05$d5aXG3Qg9PkJaAE4SciCyOeqiAzuCueRkrIAT1YOsaSZgvOfyOn5m": true,
+		"$2y$05$d5aXG3Qg9PkJaAE4SciCyOeqiAzuCueRkrIAT1YOsaSZgvOfyOn5n": false,
+		"secret": false,
+	} {
+		if checkPassword(hash, "secret") != expected {
+			t.Errorf("%s expected to match the password: %t", hash, expected)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auth-")
+	if err != nil {
+		t.Fatalf("Unable to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	reviewStatus := &TokenReviewStatus{Authenticated: true}
+	reviewStatus.User.Username = "system:serviceaccount:default:scanner"
+	reviewStatus.User.Groups = []string{"system:serviceaccounts", "inspectors"}
+
+	filter, err := NewFilter(Config{
+		TokenFile: writeTestFile(t, dir, "tokens.csv", "# token,user,uid,groups\n"+
+			"admintoken,admin,1,\"admins,users\"\n"+
+			"usertoken,user\n"+
+			"grouptoken,admins\n"),
+		HtpasswdFile: writeTestFile(t, dir, "htpasswd", "# users\n"+
+			"alice:$apr1$saltsalt$LrttParrLPdxvgutaSXWJ0\n"+
+			"bob:{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ=\n"+
+			"carol:$2y$05$d5aXG3Qg9PkJaAE4SciCyOeqiAzuCueRkrIAT1YOsaSZgvOfyOn5m\n"),
+		TokenReviewer: fakeTokenReviewer{"satoken": reviewStatus},
+		ContentUsers:  []string{"group:admins", "user:alice", "group:inspectors"},
+	})
+	if err != nil {
+		t.Fatalf("Unable to create the filter: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	ok := func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("ok")) }
+	mux.HandleFunc("/healthz", ok)
+	mux.Handle("/metadata", filter.RequireFunc(AccessMetadata, ok))
+	mux.Handle("/content", filter.RequireFunc(AccessContent, ok))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	for k, test := range map[string]struct {
+		path     string
+		token    string
+		user     string
+		password string
+		status   int
+	}{
+		"healthz without credentials":         {path: "/healthz", status: http.StatusOK},
+		"metadata without credentials":        {path: "/metadata", status: http.StatusUnauthorized},
+		"content without credentials":         {path: "/content", status: http.StatusUnauthorized},
+		"metadata with static token":          {path: "/metadata", token: "usertoken", status: http.StatusOK},
+		"content with static token":           {path: "/content", token: "usertoken", status: http.StatusForbidden},
+		"content with static token in group":  {path: "/content", token: "admintoken", status: http.StatusOK},
+		"content with user named like group":  {path: "/content", token: "grouptoken", status: http.StatusForbidden},
+		"metadata with unknown token":         {path: "/metadata", token: "nosuchtoken", status: http.StatusUnauthorized},
+		"metadata with failing review":        {path: "/metadata", token: "broken", status: http.StatusUnauthorized},
+		"content with service account token":  {path: "/content", token: "satoken", status: http.StatusOK},
+		"content with apr1 password":          {path: "/content", user: "alice", password: "secret", status: http.StatusOK},
+		"metadata with sha password":          {path: "/metadata", user: "bob", password: "secret", status: http.StatusOK},
+		"content with sha password":           {path: "/content", user: "bob", password: "secret", status: http.StatusForbidden},
+		"metadata with wrong password":        {path: "/metadata", user: "alice", password: "wrong", status: http.StatusUnauthorized},
+		"metadata with bcrypt password":       {path: "/metadata", user: "carol", password: "secret", status: http.StatusOK},
+		"metadata with wrong bcrypt password": {path: "/metadata", user: "carol", password: "wrong", status: http.StatusUnauthorized},
+		"metadata with unknown htpasswd user": {path: "/metadata", user: "dave", password: "secret", status: http.StatusUnauthorized},
+	} {
+		req, _ := http.NewRequest("GET", server.URL+test.path, nil)
+		if len(test.token) > 0 {
+			req.Header.Set("Authorization", "Bearer "+test.token)
+		}
+		if len(test.user) > 0 {
+			req.SetBasicAuth(test.user, test.password)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Errorf("%s: unable to get %s: %v", k, test.path, err)
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode != test.status {
+			t.Errorf("%s: expected status %d but got %d", k, test.status, resp.StatusCode)
+		}
+		if resp.StatusCode == http.StatusUnauthorized && len(resp.Header["Www-Authenticate"]) != 3 {
+			t.Errorf("%s: expected the challenges of all the authenticators but got %v", k, resp.Header["Www-Authenticate"])
+		}
+	}
+}
+
+func TestNewFilter(t *testing.T) {
+	filter, err := NewFilter(Config{ContentUsers: []string{"user:admin"}})
+	if err != nil || filter != nil {
+		t.Errorf("No filter should be created without authentication, got %v, %v", filter, err)
+	}
+	// a nil filter lets everything through
+	handler := filter.Require(AccessContent, http.NotFoundHandler())
+	if _, ok := handler.(http.HandlerFunc); !ok {
+		t.Errorf("A nil filter should return the handler unchanged")
+	}
+
+	dir, err := ioutil.TempDir("", "auth-")
+	if err != nil {
+		t.Fatalf("Unable to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	for k, config := range map[string]Config{
+		"missing token file":       {TokenFile: path.Join(dir, "nosuchfile")},
+		"token file without user":  {TokenFile: writeTestFile(t, dir, "tokens.csv", "token\n")},
+		"missing htpasswd file":    {HtpasswdFile: path.Join(dir, "nosuchfile")},
+		"htpasswd without hash":    {HtpasswdFile: writeTestFile(t, dir, "htpasswd", "alice\n")},
+		"htpasswd with crypt hash": {HtpasswdFile: writeTestFile(t, dir, "crypt", "alice:abJnggxhB/yWI\n")},
+	} {
+		if _, err := NewFilter(config); err == nil {
+			t.Errorf("%s should have failed", k)
+		}
+	}
+}
+
+type countingTokenReviewer struct {
+	fakeTokenReviewer
+	reviews map[string]int
+}
+
+func (r *countingTokenReviewer) Review(token string) (*TokenReviewStatus, error) {
+	r.reviews[token]++
+	return r.fakeTokenReviewer.Review(token)
+}
+
+func TestTokenReviewCache(t *testing.T) {
+	reviewStatus := &TokenReviewStatus{Authenticated: true}
+	reviewStatus.User.Username = "system:serviceaccount:default:scanner"
+	reviewer := &countingTokenReviewer{fakeTokenReviewer{"satoken": reviewStatus}, map[string]int{}}
+	authenticator := NewTokenReviewAuthenticator(reviewer)
+
+	for _, token := range []string{"satoken", "satoken", "nosuchtoken", "nosuchtoken", "broken", "broken"} {
+		req, _ := http.NewRequest("GET", "/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		authenticator.AuthenticateRequest(req)
+	}
+	for token, expected := range map[string]int{"satoken": 1, "nosuchtoken": 1, "broken": 2} {
+		if reviewer.reviews[token] != expected {
+			t.Errorf("%s expected to be reviewed %d times but was %d times", token, expected, reviewer.reviews[token])
+		}
+	}
+
+	// the expired reviews are done again
+	cache := authenticator.(*tokenReviewAuthenticator)
+	for key, review := range cache.reviews {
+		review.expires = time.Now().Add(-time.Second)
+		cache.reviews[key] = review
+	}
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set("Authorization", "Bearer satoken")
+	if user, err := authenticator.AuthenticateRequest(req); err != nil || user == nil || reviewer.reviews["satoken"] != 2 {
+		t.Errorf("An expired review should be done again, got %v, %v after %d reviews", user, err, reviewer.reviews["satoken"])
+	}
+	if len(cache.reviews) != 1 {
+		t.Errorf("The expired reviews should be removed, got %d reviews", len(cache.reviews))
+	}
+}
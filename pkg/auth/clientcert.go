@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"net/http"
+)
+
+// clientCertAuthenticator authenticates the clients presenting a TLS
+// certificate verified by the server.
+type clientCertAuthenticator struct{}
+
+// NewClientCertAuthenticator returns an Authenticator of the verified TLS
+// client certificates. The user is the common name of the certificate and
+// the groups are its organizations, like in Kubernetes.
+func NewClientCertAuthenticator() Authenticator {
+	return &clientCertAuthenticator{}
+}
+
+func (a *clientCertAuthenticator) AuthenticateRequest(r *http.Request) (*User, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+	cert := r.TLS.VerifiedChains[0][0]
+	if len(cert.Subject.CommonName) == 0 {
+		return nil, nil
+	}
+	return &User{Name: cert.Subject.CommonName, Groups: cert.Subject.Organization}, nil
+}
+
+func (a *clientCertAuthenticator) Challenge() string {
+	return ""
+}
@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"bufio"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	APR1_PREFIX = "$apr1$"
+	SHA_PREFIX  = "{SHA}"
+)
+
+// BCRYPT_PREFIXES are the prefixes of the bcrypt hashes, htpasswd -B writes
+// $2y$.
+var BCRYPT_PREFIXES = []string{"$2a$", "$2b$", "$2y$"}
+
+// htpasswdAuthenticator authenticates basic auth users of an htpasswd file.
+type htpasswdAuthenticator struct {
+	hashes map[string]string
+}
+
+// NewHtpasswdAuthenticator returns an Authenticator of the basic auth users
+// of an htpasswd file. The bcrypt (htpasswd -B), MD5 (htpasswd -m) and SHA1
+// (htpasswd -s) password hashes are supported, a file with other hashes is
+// refused rather than loaded without some of its users.
+func NewHtpasswdAuthenticator(filename string) (Authenticator, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to open the htpasswd file: %v\n", err)
+	}
+	defer file.Close()
+
+	a := &htpasswdAuthenticator{hashes: map[string]string{}}
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if len(entry) == 0 || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("htpasswd file line %d must be user:hash", line)
+		}
+		if !isBcrypt(parts[1]) && !strings.HasPrefix(parts[1], APR1_PREFIX) && !strings.HasPrefix(parts[1], SHA_PREFIX) {
+			return nil, fmt.Errorf("htpasswd file line %d: the hash of %s isn't supported, only the bcrypt, MD5 and SHA1 hashes are", line, parts[0])
+		}
+		a.hashes[parts[0]] = parts[1]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("Unable to read the htpasswd file: %v\n", err)
+	}
+	return a, nil
+}
+
+func (a *htpasswdAuthenticator) AuthenticateRequest(r *http.Request) (*User, error) {
+	name, password, ok := r.BasicAuth()
+	if !ok {
+		return nil, nil
+	}
+	hash, ok := a.hashes[name]
+	if !ok || !checkPassword(hash, password) {
+		return nil, fmt.Errorf("Invalid password for %s", name)
+	}
+	return &User{Name: name}, nil
+}
+
+func (a *htpasswdAuthenticator) Challenge() string {
+	return fmt.Sprintf("Basic realm=%q", REALM)
+}
+
+// checkPassword returns true if password matches the htpasswd hash.
+func checkPassword(hash, password string) bool {
+	var computed string
+	switch {
+	case isBcrypt(hash):
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	case strings.HasPrefix(hash, SHA_PREFIX):
+		sum := sha1.Sum([]byte(password))
+		computed = SHA_PREFIX + base64.StdEncoding.EncodeToString(sum[:])
+	case strings.HasPrefix(hash, APR1_PREFIX):
+		salt := strings.TrimPrefix(hash, APR1_PREFIX)
+		if idx := strings.Index(salt, "$"); idx >= 0 {
+			salt = salt[:idx]
+		}
+		computed = apr1(password, salt)
+	default:
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(hash)) == 1
+}
+
+// isBcrypt returns true if hash is a bcrypt hash.
+func isBcrypt(hash string) bool {
+	for _, prefix := range BCRYPT_PREFIXES {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// apr1 returns the Apache variant of the MD5-based crypt hash of password.
+func apr1(password, salt string) string {
+	if len(salt) > 8 {
+		salt = salt[:8]
+	}
+	pw := []byte(password)
+
+	alternate := md5.New()
+	alternate.Write(pw)
+	alternate.Write([]byte(salt))
+	alternate.Write(pw)
+	alternateSum := alternate.Sum(nil)
+
+	ctx := md5.New()
+	ctx.Write(pw)
+	ctx.Write([]byte(APR1_PREFIX + salt))
+	for i := len(pw); i > 0; i -= md5.Size {
+		if i > md5.Size {
+			ctx.Write(alternateSum)
+		} else {
+			ctx.Write(alternateSum[:i])
+		}
+	}
+	for i := len(pw); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			ctx.Write([]byte{0})
+		} else {
+			ctx.Write(pw[:1])
+		}
+	}
+	sum := ctx.Sum(nil)
+
+	for i := 0; i < 1000; i++ {
+		round := md5.New()
+		if i&1 != 0 {
+			round.Write(pw)
+		} else {
+			round.Write(sum)
+		}
+		if i%3 != 0 {
+			round.Write([]byte(salt))
+		}
+		if i%7 != 0 {
+			round.Write(pw)
+		}
+		if i&1 != 0 {
+			round.Write(sum)
+		} else {
+			round.Write(pw)
+		}
+		sum = round.Sum(nil)
+	}
+
+	const itoa64 = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	encoded := make([]byte, 0, 22)
+	encode := func(v uint, n int) {
+		for ; n > 0; n-- {
+			encoded = append(encoded, itoa64[v&0x3f])
+			v >>= 6
+		}
+	}
+	for _, idx := range [][3]int{{0, 6, 12}, {1, 7, 13}, {2, 8, 14}, {3, 9, 15}, {4, 10, 5}} {
+		encode(uint(sum[idx[0]])<<16|uint(sum[idx[1]])<<8|uint(sum[idx[2]]), 4)
+	}
+	encode(uint(sum[11]), 2)
+	return APR1_PREFIX + salt + "$" + string(encoded)
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// tokenFileAuthenticator authenticates static bearer tokens.
+type tokenFileAuthenticator struct {
+	// users are keyed by the digest of their token so that the lookup does
+	// not leak the tokens through its timing
+	users map[[sha256.Size]byte]*User
+}
+
+// NewTokenFileAuthenticator returns an Authenticator of the bearer tokens
+// listed in a CSV file, in the same format as the Kubernetes static token
+// file: token,user,uid,"group1,group2". The uid and the groups are optional.
+func NewTokenFileAuthenticator(filename string) (Authenticator, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to open the token file: %v\n", err)
+	}
+	defer file.Close()
+
+	a := &tokenFileAuthenticator{users: map[[sha256.Size]byte]*User{}}
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("Unable to read the token file: %v\n", err)
+		}
+		if len(record) < 2 || len(record[0]) == 0 || len(record[1]) == 0 {
+			return nil, fmt.Errorf("Token file line %d must be token,user[,uid[,groups]]", line)
+		}
+		user := &User{Name: record[1]}
+		if len(record) > 3 && len(record[3]) > 0 {
+			user.Groups = strings.Split(record[3], ",")
+		}
+		a.users[sha256.Sum256([]byte(record[0]))] = user
+	}
+	return a, nil
+}
+
+func (a *tokenFileAuthenticator) AuthenticateRequest(r *http.Request) (*User, error) {
+	token := bearerToken(r)
+	if len(token) == 0 {
+		return nil, nil
+	}
+	// unknown tokens are left to the other authenticators
+	return a.users[sha256.Sum256([]byte(token))], nil
+}
+
+func (a *tokenFileAuthenticator) Challenge() string {
+	return fmt.Sprintf("Bearer realm=%q", REALM)
+}
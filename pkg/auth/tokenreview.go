@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/openshift/image-inspector/pkg/kube"
+)
+
+const (
+	TOKEN_REVIEW_API_PATH = "/apis/authentication.k8s.io/v1/tokenreviews"
+	TOKEN_REVIEW_TIMEOUT  = 10 * time.Second
+	// TOKEN_REVIEW_CACHE_TTL is how long the review of an authenticated
+	// token is reused
+	TOKEN_REVIEW_CACHE_TTL = time.Minute
+	// TOKEN_REVIEW_FAILURE_CACHE_TTL is how long the review of a rejected
+	// token is reused
+	TOKEN_REVIEW_FAILURE_CACHE_TTL = 10 * time.Second
+	tokenReviewAPIVersion          = "authentication.k8s.io/v1"
+	tokenReviewKind                = "TokenReview"
+)
+
+// TokenReviewStatus is the outcome of the review of a token, a subset of
+// the status of a Kubernetes TokenReview.
+type TokenReviewStatus struct {
+	Authenticated bool `json:"authenticated"`
+	User          struct {
+		Username string   `json:"username"`
+		UID      string   `json:"uid"`
+		Groups   []string `json:"groups"`
+	} `json:"user"`
+	// Audiences are the audiences of the review the token is valid for
+	Audiences []string `json:"audiences,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// TokenReviewer validates a bearer token, like the Kubernetes TokenReview API.
+type TokenReviewer interface {
+	Review(token string) (*TokenReviewStatus, error)
+}
+
+// cachedReview is the review of a token and when it expires.
+type cachedReview struct {
+	status  *TokenReviewStatus
+	expires time.Time
+}
+
+// tokenReviewAuthenticator authenticates the bearer tokens with a
+// TokenReviewer, reusing the reviews for a while. The tokens are only kept
+// hashed.
+type tokenReviewAuthenticator struct {
+	reviewer TokenReviewer
+
+	lock    sync.Mutex
+	reviews map[[sha256.Size]byte]cachedReview
+}
+
+// NewTokenReviewAuthenticator returns an Authenticator of the bearer tokens
+// validated by reviewer.
+func NewTokenReviewAuthenticator(reviewer TokenReviewer) Authenticator {
+	return &tokenReviewAuthenticator{reviewer: reviewer, reviews: map[[sha256.Size]byte]cachedReview{}}
+}
+
+func (a *tokenReviewAuthenticator) AuthenticateRequest(r *http.Request) (*User, error) {
+	token := bearerToken(r)
+	if len(token) == 0 {
+		return nil, nil
+	}
+	status, err := a.review(token)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to review the token: %v", err)
+	}
+	if !status.Authenticated {
+		return nil, fmt.Errorf("Invalid token: %s", status.Error)
+	}
+	return &User{Name: status.User.Username, Groups: status.User.Groups}, nil
+}
+
+// review returns the cached review of token or else reviews it, caching the
+// outcome unless the review failed.
+func (a *tokenReviewAuthenticator) review(token string) (*TokenReviewStatus, error) {
+	key := sha256.Sum256([]byte(token))
+	now := time.Now()
+	a.lock.Lock()
+	cached, ok := a.reviews[key]
+	a.lock.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.status, nil
+	}
+
+	status, err := a.reviewer.Review(token)
+	if err != nil {
+		return nil, err
+	}
+	ttl := TOKEN_REVIEW_CACHE_TTL
+	if !status.Authenticated {
+		ttl = TOKEN_REVIEW_FAILURE_CACHE_TTL
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	for k, review := range a.reviews {
+		if !now.Before(review.expires) {
+			delete(a.reviews, k)
+		}
+	}
+	a.reviews[key] = cachedReview{status: status, expires: now.Add(ttl)}
+	return status, nil
+}
+
+func (a *tokenReviewAuthenticator) Challenge() string {
+	return fmt.Sprintf("Bearer realm=%q", REALM)
+}
+
+// tokenReview is the TokenReview resource sent to the API server.
+type tokenReview struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Spec       struct {
+		Token     string   `json:"token"`
+		Audiences []string `json:"audiences,omitempty"`
+	} `json:"spec"`
+	Status TokenReviewStatus `json:"status"`
+}
+
+// apiTokenReviewer reviews the tokens with the TokenReview API of a
+// Kubernetes API server, accepting only those issued for its audiences.
+type apiTokenReviewer struct {
+	client    *kube.Client
+	audiences []string
+}
+
+// NewInClusterTokenReviewer returns a TokenReviewer using the TokenReview
+// API of the cluster the pod runs in, with the credentials of the pod
+// service account, for the tokens issued for one of audiences.
+func NewInClusterTokenReviewer(audiences []string) (TokenReviewer, error) {
+	client, err := kube.NewInClusterClient(TOKEN_REVIEW_TIMEOUT)
+	if err != nil {
+		return nil, err
+	}
+	return &apiTokenReviewer{client: client, audiences: audiences}, nil
+}
+
+func (r *apiTokenReviewer) Review(token string) (*TokenReviewStatus, error) {
+	review := tokenReview{APIVersion: tokenReviewAPIVersion, Kind: tokenReviewKind}
+	review.Spec.Token = token
+	review.Spec.Audiences = r.audiences
+	if err := r.client.Do("POST", TOKEN_REVIEW_API_PATH, "", review, &review); err != nil {
+		return nil, err
+	}
+	status := &review.Status
+	if status.Authenticated && len(r.audiences) > 0 && !intersects(status.Audiences, r.audiences) {
+		// an API server ignoring the audiences validates the tokens of any audience
+		status.Authenticated = false
+		status.Error = fmt.Sprintf("the token is not issued for %v", r.audiences)
+	}
+	return status, nil
+}
+
+// intersects returns true when a and b have a value in common.
+func intersects(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
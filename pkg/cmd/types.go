@@ -2,8 +2,13 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/openshift/image-inspector/pkg/attestation"
 	oscapscanner "github.com/openshift/image-inspector/pkg/openscap"
+	"github.com/openshift/image-inspector/pkg/registry"
+	"github.com/openshift/image-inspector/pkg/signature"
 
 	iiapi "github.com/openshift/image-inspector/pkg/api"
 
@@ -29,10 +34,37 @@ func (sv *MultiStringVar) String() string {
 type ImageInspectorOptions struct {
 	// URI contains the location of the docker daemon socket to connect to.
 	URI string
+	// Runtime is the container runtime the image is pulled with, one of
+	// iiapi.RuntimeOptions
+	Runtime string
+	// RuntimeEndpoint is the socket of the podman or CRI runtime, the default one of
+	// the runtime when empty
+	RuntimeEndpoint string
 	// Image contains the docker image to inspect.
 	Image string
+	// Container is the id or name of a container, running or not, to inspect
+	// instead of an image
+	Container string
+	// Platform is the os/arch[/variant] of the image to select from its manifest
+	// list, the one the runtime defaults to when empty
+	Platform string
+	// AllPlatforms inspects every platform of the manifest list of the image, each
+	// one with its own metadata
+	AllPlatforms bool
+	// AllowUnresolvedTag pulls the tags whose digest can't be resolved from their
+	// registry as they are, trusting the digest the runtime reports, instead of
+	// failing the inspection
+	AllowUnresolvedTag bool
+	// SignaturePolicy is the policy file of the keys trusted to sign the images,
+	// their signatures are verified before extracting them when set
+	SignaturePolicy string
+	// RequireSignatures aborts the inspection of the images whose signatures
+	// don't satisfy the policy instead of recording the failure
+	RequireSignatures bool
 	// DstPath is the destination path for image files.
 	DstPath string
+	// Keep retains the temporary directories instead of removing them on exit.
+	Keep bool
 	// Serve holds the host and port for where to serve the image with webdav.
 	Serve string
 	// Chroot controls whether or not a chroot is excuted when serving the image with webdav.
@@ -52,23 +84,144 @@ type ImageInspectorOptions struct {
 	OpenScapHTML bool
 	// CVEUrlPath An alternative source for the cve files
 	CVEUrlPath string
+	// ExtractLayers controls whether the image is extracted layer by layer from its
+	// export, recording which layer introduced each file, instead of exporting a
+	// container created from it. Containers are always exported as they are.
+	ExtractLayers bool
+	// MinEfficiency is the lowest acceptable image efficiency score, 0 disables the check.
+	MinEfficiency float64
+	// AttestationKey is the private key file signing the attestation of the
+	// inspection, no attestation is made when empty
+	AttestationKey string
+	// Output is the format of the report written at the end of the inspection
+	Output string
+	// OutputFile is the file the report is written to, stdout when empty
+	OutputFile string
+	// Daemon controls whether to run a long-running inspection service accepting
+	// images to inspect through the API instead of inspecting a single image.
+	Daemon bool
+	// Workers is the number of inspections the inspection service runs in parallel
+	Workers int
+	// QueueSize is the number of inspections the inspection service keeps waiting
+	QueueSize int
+	// InspectionRetention is the number of finished inspections the inspection
+	// service keeps, with their content, the older ones keeping only their result
+	InspectionRetention int
+	// InspectionTTL is how long the inspection service keeps the finished
+	// inspections, with their content, forever when 0
+	InspectionTTL time.Duration
+	// StorePath is the directory of the on-disk results store. The results of the
+	// inspections are recorded there when set.
+	StorePath string
+	// AuthTokenFile is a CSV file of the bearer tokens allowed to access the server
+	AuthTokenFile string
+	// AuthHtpasswdFile is an htpasswd file of the users allowed to access the server
+	AuthHtpasswdFile string
+	// AuthTokenReview controls whether the Kubernetes service account tokens are
+	// allowed to access the server, validated with the TokenReview API
+	AuthTokenReview bool
+	// AuthTokenAudience is the audience the tokens validated with the TokenReview
+	// API must be issued for
+	AuthTokenAudience string
+	// AuthContentUsers are the users, as user:<name>, and the groups, as
+	// group:<name>, allowed to access the image content, all the authenticated
+	// users when empty
+	AuthContentUsers MultiStringVar
+	// TLSCert is the certificate file used to serve with TLS
+	TLSCert string
+	// TLSKey is the key file of TLSCert
+	TLSKey string
+	// TLSClientCA is a file of CAs used to verify the client certificates
+	TLSClientCA string
+	// ServeTimeout stops serving after this long, 0 serves forever
+	ServeTimeout time.Duration
+	// IdleTimeout stops serving when no request is received for this long, 0 never does
+	IdleTimeout time.Duration
+	// ExitAfterDownloads stops serving once the scan report was downloaded this many
+	// times, 0 never does
+	ExitAfterDownloads int
+	// Webhook controls whether the inspection service serves a validating admission
+	// webhook for Pods
+	Webhook bool
+	// WebhookFailOpen admits the pods whose images can't be evaluated by the webhook
+	// instead of denying them
+	WebhookFailOpen bool
+	// WebhookDenySeverity is the lowest severity of the failed findings denying a pod
+	WebhookDenySeverity string
+	// Controller controls whether the inspection service discovers and inspects the
+	// images run by the pods of the cluster
+	Controller bool
+	// ControllerInterval is the minimum time between two inspections submitted by the
+	// controller
+	ControllerInterval time.Duration
+	// Publish is where the summary of the inspections is published to Kubernetes, one
+	// of iiapi.PublishOptions, nothing is published when empty
+	Publish string
+	// PublishPods are the pods, as namespace/name, annotated with the summary of a
+	// single inspection
+	PublishPods MultiStringVar
+	// PublishNamespace is the namespace of the ImageScanResult resources
+	PublishNamespace string
+	// PublishURL is the URL the server is reachable at, used to link the results from
+	// the published summaries
+	PublishURL string
 }
 
 // NewDefaultImageInspectorOptions provides a new ImageInspectorOptions with default values.
 func NewDefaultImageInspectorOptions() *ImageInspectorOptions {
 	return &ImageInspectorOptions{
-		URI:            "unix:///var/run/docker.sock",
-		Image:          "",
-		DstPath:        "",
-		Serve:          "",
-		Chroot:         false,
-		DockerCfg:      MultiStringVar{[]string{}},
-		Username:       "",
-		PasswordFile:   "",
-		ScanType:       "",
-		ScanResultsDir: "",
-		OpenScapHTML:   false,
-		CVEUrlPath:     oscapscanner.CVEUrl,
+		URI:                 "unix:///var/run/docker.sock",
+		Runtime:             "docker",
+		RuntimeEndpoint:     "",
+		Image:               "",
+		Container:           "",
+		Platform:            "",
+		AllPlatforms:        false,
+		AllowUnresolvedTag:  false,
+		SignaturePolicy:     "",
+		RequireSignatures:   false,
+		DstPath:             "",
+		Keep:                false,
+		Serve:               "",
+		Chroot:              false,
+		DockerCfg:           MultiStringVar{[]string{}},
+		Username:            "",
+		PasswordFile:        "",
+		ScanType:            "",
+		ScanResultsDir:      "",
+		OpenScapHTML:        false,
+		CVEUrlPath:          oscapscanner.CVEUrl,
+		ExtractLayers:       true,
+		MinEfficiency:       0,
+		AttestationKey:      "",
+		Output:              "",
+		OutputFile:          "",
+		Daemon:              false,
+		Workers:             2,
+		QueueSize:           20,
+		InspectionRetention: 50,
+		InspectionTTL:       24 * time.Hour,
+		StorePath:           "",
+		AuthTokenFile:       "",
+		AuthHtpasswdFile:    "",
+		AuthTokenReview:     false,
+		AuthTokenAudience:   "image-inspector",
+		AuthContentUsers:    MultiStringVar{[]string{}},
+		TLSCert:             "",
+		TLSKey:              "",
+		TLSClientCA:         "",
+		ServeTimeout:        0,
+		IdleTimeout:         0,
+		ExitAfterDownloads:  0,
+		Webhook:             false,
+		WebhookFailOpen:     false,
+		WebhookDenySeverity: "high",
+		Controller:          false,
+		ControllerInterval:  10 * time.Second,
+		Publish:             "",
+		PublishPods:         MultiStringVar{[]string{}},
+		PublishNamespace:    "",
+		PublishURL:          "",
 	}
 }
 
@@ -77,9 +230,36 @@ func (i *ImageInspectorOptions) Validate() error {
 	if len(i.URI) == 0 {
 		return fmt.Errorf("Docker socket connection must be specified")
 	}
-	if len(i.Image) == 0 {
+	if err := i.validateRuntime(); err != nil {
+		return err
+	}
+	if i.Daemon {
+		return i.validateDaemon()
+	}
+	if len(i.Image) == 0 && len(i.Container) == 0 {
 		return fmt.Errorf("Docker image to inspect must be specified")
 	}
+	if len(i.Image) > 0 && len(i.Container) > 0 {
+		return fmt.Errorf("Only specify an image or a container to inspect")
+	}
+	// the image ids are only known locally, the references are resolved to digests
+	if len(i.Image) > 0 && !strings.HasPrefix(i.Image, "sha256:") {
+		if _, err := registry.ParseReference(i.Image); err != nil {
+			return err
+		}
+	}
+	if err := i.validatePlatform(); err != nil {
+		return err
+	}
+	if err := i.validateSignatures(); err != nil {
+		return err
+	}
+	if err := i.validateAttestation(); err != nil {
+		return err
+	}
+	if len(i.Container) > 0 && i.MinEfficiency > 0 {
+		return fmt.Errorf("The efficiency of a container can't be analyzed, its filesystem isn't extracted layer by layer")
+	}
 	if len(i.DockerCfg.Values) > 0 && len(i.Username) > 0 {
 		return fmt.Errorf("Only specify dockercfg file or username/password pair for authentication")
 	}
@@ -101,6 +281,12 @@ func (i *ImageInspectorOptions) Validate() error {
 	if i.OpenScapHTML && (len(i.ScanType) == 0 || i.ScanType != "openscap") {
 		return fmt.Errorf("OpenScapHtml can be used only when specifying scan-type as \"openscap\"")
 	}
+	if i.MinEfficiency < 0 || i.MinEfficiency > 1 {
+		return fmt.Errorf("min-efficiency must be between 0 and 1")
+	}
+	if i.MinEfficiency > 0 && !i.ExtractLayers {
+		return fmt.Errorf("min-efficiency can be used only when extracting the image layer by layer")
+	}
 	for _, fl := range append(i.DockerCfg.Values, i.PasswordFile) {
 		if len(fl) > 0 {
 			if _, err := os.Stat(fl); os.IsNotExist(err) {
@@ -108,6 +294,46 @@ func (i *ImageInspectorOptions) Validate() error {
 			}
 		}
 	}
+	if err := validateStorePath(i.StorePath); err != nil {
+		return err
+	}
+	if err := i.validateAuth(); err != nil {
+		return err
+	}
+	if err := i.validateShutdown(); err != nil {
+		return err
+	}
+	if i.Webhook || i.WebhookFailOpen {
+		return fmt.Errorf("The admission webhook can be served only by the inspection service")
+	}
+	if i.Controller {
+		return fmt.Errorf("The controller can be run only by the inspection service")
+	}
+	if err := i.validatePublish(); err != nil {
+		return err
+	}
+	if i.Publish == "pod" && len(i.PublishPods.Values) == 0 {
+		return fmt.Errorf("Please specify the pods to annotate with publish-pod")
+	}
+	if i.ExitAfterDownloads > 0 && len(i.ScanType) == 0 {
+		return fmt.Errorf("exit-after-downloads can be used only when specifying scan-type")
+	}
+	if len(i.OutputFile) > 0 && len(i.Output) == 0 {
+		return fmt.Errorf("output-file can be used only when specifying output")
+	}
+	if len(i.Output) > 0 {
+		var found bool = false
+		for _, opt := range iiapi.OutputOptions {
+			if i.Output == opt {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s is not one of the available outputs which are %v",
+				i.Output, iiapi.OutputOptions)
+		}
+	}
 	if len(i.ScanType) > 0 {
 		var found bool = false
 		for _, opt := range iiapi.ScanOptions {
@@ -124,3 +350,265 @@ func (i *ImageInspectorOptions) Validate() error {
 	}
 	return nil
 }
+
+// validateDaemon performs validation on the field settings of the inspection service.
+func (i *ImageInspectorOptions) validateDaemon() error {
+	if len(i.Serve) == 0 {
+		return fmt.Errorf("The inspection service must be served, please specify serve")
+	}
+	if len(i.Image) > 0 || len(i.ScanType) > 0 || len(i.ScanResultsDir) > 0 || len(i.Output) > 0 {
+		return fmt.Errorf("The image and the scan to run are part of the requests to the inspection service")
+	}
+	if i.Chroot {
+		return fmt.Errorf("Change root can't be used by the inspection service")
+	}
+	if len(i.Container) > 0 {
+		return fmt.Errorf("Containers can't be inspected by the inspection service")
+	}
+	if len(i.Platform) > 0 || i.AllPlatforms {
+		return fmt.Errorf("The platforms can't be selected by the inspection service")
+	}
+	if err := i.validateSignatures(); err != nil {
+		return err
+	}
+	if err := i.validateAttestation(); err != nil {
+		return err
+	}
+	if i.Workers < 1 || i.QueueSize < 1 {
+		return fmt.Errorf("The inspection service needs at least one worker and a queue size of one")
+	}
+	if i.InspectionRetention < 1 || i.InspectionTTL < 0 {
+		return fmt.Errorf("The inspection service must keep at least one finished inspection, for a positive duration")
+	}
+	if err := validateStorePath(i.StorePath); err != nil {
+		return err
+	}
+	if err := i.validateAuth(); err != nil {
+		return err
+	}
+	if err := i.validateShutdown(); err != nil {
+		return err
+	}
+	if i.ExitAfterDownloads > 0 {
+		return fmt.Errorf("exit-after-downloads can't be used by the inspection service")
+	}
+	if err := i.validateWebhook(); err != nil {
+		return err
+	}
+	if i.Controller && i.ControllerInterval <= 0 {
+		return fmt.Errorf("controller-interval must be positive")
+	}
+	if err := i.validatePublish(); err != nil {
+		return err
+	}
+	if i.Publish == "pod" && (!i.Controller || len(i.PublishPods.Values) > 0) {
+		return fmt.Errorf("The inspection service annotates the pods discovered by the controller, please specify controller and no publish-pod")
+	}
+	if len(i.DockerCfg.Values) > 0 && len(i.Username) > 0 {
+		return fmt.Errorf("Only specify dockercfg file or username/password pair for authentication")
+	}
+	if len(i.Username) > 0 && len(i.PasswordFile) == 0 {
+		return fmt.Errorf("Please specify password for the username")
+	}
+	for _, fl := range append(i.DockerCfg.Values, i.PasswordFile) {
+		if len(fl) > 0 {
+			if _, err := os.Stat(fl); os.IsNotExist(err) {
+				return fmt.Errorf("%s does not exist", fl)
+			}
+		}
+	}
+	return nil
+}
+
+// validateRuntime performs validation on the settings of the container runtime.
+func (i *ImageInspectorOptions) validateRuntime() error {
+	var found bool = false
+	for _, opt := range iiapi.RuntimeOptions {
+		if i.Runtime == opt {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("%s is not one of the available runtimes which are %v",
+			i.Runtime, iiapi.RuntimeOptions)
+	}
+	if i.Runtime == "docker" && len(i.RuntimeEndpoint) > 0 {
+		return fmt.Errorf("runtime-endpoint can't be used with the docker runtime, please specify docker")
+	}
+	if i.Runtime == "cri" && len(i.Container) > 0 {
+		return fmt.Errorf("The cri runtime can't export the filesystem of containers")
+	}
+	if i.Runtime == "cri" && !i.ExtractLayers {
+		return fmt.Errorf("The cri runtime can only extract the images layer by layer, layers can't be disabled")
+	}
+	return nil
+}
+
+// validatePlatform performs validation on the platform selection.
+func (i *ImageInspectorOptions) validatePlatform() error {
+	if len(i.Platform) == 0 && !i.AllPlatforms {
+		return nil
+	}
+	if len(i.Platform) > 0 && i.AllPlatforms {
+		return fmt.Errorf("Only specify a platform or all the platforms")
+	}
+	if len(i.Container) > 0 {
+		return fmt.Errorf("The platform of a container can't be selected")
+	}
+	if i.AllPlatforms && len(i.Serve) > 0 {
+		return fmt.Errorf("All the platforms can't be served, please specify a platform")
+	}
+	if len(i.Platform) > 0 {
+		if _, err := registry.ParsePlatform(i.Platform); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSignatures performs validation on the signature verification.
+func (i *ImageInspectorOptions) validateSignatures() error {
+	if len(i.SignaturePolicy) == 0 {
+		if i.RequireSignatures {
+			return fmt.Errorf("require-signatures can be used only when specifying signature-policy")
+		}
+		return nil
+	}
+	if len(i.Container) > 0 {
+		return fmt.Errorf("The signatures of a container can't be verified, please specify an image")
+	}
+	if strings.HasPrefix(i.Image, "sha256:") {
+		return fmt.Errorf("The signatures of %s can't be verified, please specify the image by name", i.Image)
+	}
+	if _, err := signature.LoadPolicy(i.SignaturePolicy); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateAttestation performs validation on the attestation of the inspection.
+func (i *ImageInspectorOptions) validateAttestation() error {
+	if len(i.AttestationKey) == 0 {
+		return nil
+	}
+	if len(i.Container) > 0 {
+		return fmt.Errorf("The inspection of a container can't be attested, it has no digest")
+	}
+	if strings.HasPrefix(i.Image, "sha256:") {
+		return fmt.Errorf("The inspection of %s can't be attested, please specify the image by name", i.Image)
+	}
+	if _, err := attestation.LoadSigner(i.AttestationKey); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateWebhook performs validation on the settings of the admission webhook.
+func (i *ImageInspectorOptions) validateWebhook() error {
+	if i.WebhookFailOpen && !i.Webhook {
+		return fmt.Errorf("webhook-fail-open can be used only when serving the admission webhook")
+	}
+	if i.Webhook && len(i.TLSCert) == 0 {
+		return fmt.Errorf("The admission webhook must be served with TLS, please specify tls-cert")
+	}
+	for _, severity := range iiapi.SeverityOptions {
+		if i.WebhookDenySeverity == severity {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not one of the available severities which are %v",
+		i.WebhookDenySeverity, iiapi.SeverityOptions)
+}
+
+// validatePublish performs validation on the settings of the publication of
+// the summaries to Kubernetes.
+func (i *ImageInspectorOptions) validatePublish() error {
+	if len(i.Publish) == 0 {
+		if len(i.PublishPods.Values) > 0 || len(i.PublishNamespace) > 0 || len(i.PublishURL) > 0 {
+			return fmt.Errorf("publish-pod, publish-namespace and publish-url can be used only when specifying publish")
+		}
+		return nil
+	}
+	var found bool = false
+	for _, opt := range iiapi.PublishOptions {
+		if i.Publish == opt {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("%s is not one of the available publish options which are %v",
+			i.Publish, iiapi.PublishOptions)
+	}
+	if len(i.PublishPods.Values) > 0 && i.Publish != "pod" {
+		return fmt.Errorf("publish-pod can be used only when publishing on the pods")
+	}
+	for _, pod := range i.PublishPods.Values {
+		if parts := strings.Split(pod, "/"); len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			return fmt.Errorf("%s is not a pod namespace/name", pod)
+		}
+	}
+	if (i.Publish == "resource") != (len(i.PublishNamespace) > 0) {
+		return fmt.Errorf("publish-namespace must be specified when, and only when, publishing resources")
+	}
+	return nil
+}
+
+// validateStorePath checks that the results store can be created in storePath.
+func validateStorePath(storePath string) error {
+	if len(storePath) > 0 {
+		fi, err := os.Stat(storePath)
+		if err == nil && !fi.IsDir() {
+			return fmt.Errorf("%s is not a directory", storePath)
+		}
+	}
+	return nil
+}
+
+// validateAuth performs validation on the authentication and TLS settings.
+func (i *ImageInspectorOptions) validateAuth() error {
+	if (len(i.TLSCert) > 0) != (len(i.TLSKey) > 0) {
+		return fmt.Errorf("tls-cert and tls-key must be specified together")
+	}
+	if len(i.TLSClientCA) > 0 && len(i.TLSCert) == 0 {
+		return fmt.Errorf("tls-client-ca can be used only when serving with TLS")
+	}
+	if len(i.TLSCert) > 0 && len(i.Serve) == 0 {
+		return fmt.Errorf("TLS can be used only when serving")
+	}
+	enabled := len(i.AuthTokenFile) > 0 || len(i.AuthHtpasswdFile) > 0 || i.AuthTokenReview || len(i.TLSClientCA) > 0
+	if enabled && len(i.Serve) == 0 {
+		return fmt.Errorf("Authentication can be used only when serving")
+	}
+	if len(i.AuthContentUsers.Values) > 0 && !enabled {
+		return fmt.Errorf("auth-content-user can be used only when authentication is enabled")
+	}
+	for _, entry := range i.AuthContentUsers.Values {
+		if !strings.HasPrefix(entry, "user:") && !strings.HasPrefix(entry, "group:") {
+			return fmt.Errorf("auth-content-user %s must be user:<name> or group:<name>", entry)
+		}
+	}
+	if i.AuthTokenReview && len(i.AuthTokenAudience) == 0 {
+		return fmt.Errorf("auth-token-review needs the audience of the tokens, please specify auth-token-audience")
+	}
+	for _, fl := range []string{i.AuthTokenFile, i.AuthHtpasswdFile, i.TLSCert, i.TLSKey, i.TLSClientCA} {
+		if len(fl) > 0 {
+			if _, err := os.Stat(fl); os.IsNotExist(err) {
+				return fmt.Errorf("%s does not exist", fl)
+			}
+		}
+	}
+	return nil
+}
+
+// validateShutdown performs validation on the settings stopping the server.
+func (i *ImageInspectorOptions) validateShutdown() error {
+	if i.ServeTimeout < 0 || i.IdleTimeout < 0 || i.ExitAfterDownloads < 0 {
+		return fmt.Errorf("serve-timeout, idle-timeout and exit-after-downloads can't be negative")
+	}
+	if (i.ServeTimeout > 0 || i.IdleTimeout > 0 || i.ExitAfterDownloads > 0) && len(i.Serve) == 0 {
+		return fmt.Errorf("serve-timeout, idle-timeout and exit-after-downloads can be used only when serving")
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestValidate(t *testing.T) {
@@ -27,6 +28,12 @@ func TestValidate(t *testing.T) {
 	goodConfigUsername.Username = "username"
 	goodConfigUsername.PasswordFile = "types.go"
 
+	goodDigestReference := NewDefaultImageInspectorOptions()
+	goodDigestReference.Image = "docker.io/library/fedora@sha256:0123456789abcdef"
+
+	badDigestReference := NewDefaultImageInspectorOptions()
+	badDigestReference.Image = "fedora@sha256:nothex"
+
 	goodConfigWithDockerCfg := NewDefaultImageInspectorOptions()
 	goodConfigWithDockerCfg.Image = "image"
 	goodConfigWithDockerCfg.DockerCfg.Set("types.go")
@@ -64,24 +71,462 @@ func TestValidate(t *testing.T) {
 	badScanOptionsHTMLWrongScan.OpenScapHTML = true
 	badScanOptionsHTMLWrongScan.ScanType = "nosuchscantype"
 
+	goodMinEfficiency := NewDefaultImageInspectorOptions()
+	goodMinEfficiency.Image = "image"
+	goodMinEfficiency.ExtractLayers = true
+	goodMinEfficiency.MinEfficiency = 0.9
+
+	badMinEfficiencyNoLayers := NewDefaultImageInspectorOptions()
+	badMinEfficiencyNoLayers.Image = "image"
+	badMinEfficiencyNoLayers.ExtractLayers = false
+	badMinEfficiencyNoLayers.MinEfficiency = 0.9
+
+	badMinEfficiencyRange := NewDefaultImageInspectorOptions()
+	badMinEfficiencyRange.Image = "image"
+	badMinEfficiencyRange.ExtractLayers = true
+	badMinEfficiencyRange.MinEfficiency = 90
+
+	goodOutput := NewDefaultImageInspectorOptions()
+	goodOutput.Image = "image"
+	goodOutput.Output = "yaml"
+	goodOutput.OutputFile = "report.yaml"
+
+	badOutput := NewDefaultImageInspectorOptions()
+	badOutput.Image = "image"
+	badOutput.Output = "nosuchoutput"
+
+	badOutputFileNoOutput := NewDefaultImageInspectorOptions()
+	badOutputFileNoOutput.Image = "image"
+	badOutputFileNoOutput.OutputFile = "report.json"
+
+	goodDaemon := NewDefaultImageInspectorOptions()
+	goodDaemon.Daemon = true
+	goodDaemon.Serve = "0.0.0.0:8080"
+
+	badDaemonNoServe := NewDefaultImageInspectorOptions()
+	badDaemonNoServe.Daemon = true
+
+	badDaemonWithImage := NewDefaultImageInspectorOptions()
+	badDaemonWithImage.Daemon = true
+	badDaemonWithImage.Serve = "0.0.0.0:8080"
+	badDaemonWithImage.Image = "image"
+
+	badDaemonChroot := NewDefaultImageInspectorOptions()
+	badDaemonChroot.Daemon = true
+	badDaemonChroot.Serve = "0.0.0.0:8080"
+	badDaemonChroot.Chroot = true
+
+	badDaemonNoWorkers := NewDefaultImageInspectorOptions()
+	badDaemonNoWorkers.Daemon = true
+	badDaemonNoWorkers.Serve = "0.0.0.0:8080"
+	badDaemonNoWorkers.Workers = 0
+
+	goodStorePath := NewDefaultImageInspectorOptions()
+	goodStorePath.Image = "image"
+	goodStorePath.StorePath = "."
+
+	badStorePathNotADir := NewDefaultImageInspectorOptions()
+	badStorePathNotADir.Image = "image"
+	badStorePathNotADir.StorePath = "types_test.go"
+
+	badDaemonStorePathNotADir := NewDefaultImageInspectorOptions()
+	badDaemonStorePathNotADir.Daemon = true
+	badDaemonStorePathNotADir.Serve = "0.0.0.0:8080"
+	badDaemonStorePathNotADir.StorePath = "types_test.go"
+
+	goodAuth := NewDefaultImageInspectorOptions()
+	goodAuth.Image = "image"
+	goodAuth.Serve = "0.0.0.0:8080"
+	goodAuth.AuthTokenFile = "types_test.go"
+	goodAuth.AuthContentUsers.Values = []string{"user:admin"}
+
+	badAuthNoServe := NewDefaultImageInspectorOptions()
+	badAuthNoServe.Image = "image"
+	badAuthNoServe.AuthTokenReview = true
+
+	badAuthContentUsersOnly := NewDefaultImageInspectorOptions()
+	badAuthContentUsersOnly.Image = "image"
+	badAuthContentUsersOnly.Serve = "0.0.0.0:8080"
+	badAuthContentUsersOnly.AuthContentUsers.Values = []string{"user:admin"}
+
+	badAuthContentUserNoPrefix := NewDefaultImageInspectorOptions()
+	badAuthContentUserNoPrefix.Image = "image"
+	badAuthContentUserNoPrefix.Serve = "0.0.0.0:8080"
+	badAuthContentUserNoPrefix.AuthTokenFile = "types_test.go"
+	badAuthContentUserNoPrefix.AuthContentUsers.Values = []string{"admin"}
+
+	badAuthTokenReviewNoAudience := NewDefaultImageInspectorOptions()
+	badAuthTokenReviewNoAudience.Image = "image"
+	badAuthTokenReviewNoAudience.Serve = "0.0.0.0:8080"
+	badAuthTokenReviewNoAudience.AuthTokenReview = true
+	badAuthTokenReviewNoAudience.AuthTokenAudience = ""
+
+	badAuthNoSuchHtpasswd := NewDefaultImageInspectorOptions()
+	badAuthNoSuchHtpasswd.Daemon = true
+	badAuthNoSuchHtpasswd.Serve = "0.0.0.0:8080"
+	badAuthNoSuchHtpasswd.AuthHtpasswdFile = "/nosuchfile"
+
+	goodTLS := NewDefaultImageInspectorOptions()
+	goodTLS.Image = "image"
+	goodTLS.Serve = "0.0.0.0:8443"
+	goodTLS.TLSCert = "types.go"
+	goodTLS.TLSKey = "types_test.go"
+	goodTLS.TLSClientCA = "types.go"
+	goodTLS.AuthContentUsers.Values = []string{"user:admin"}
+
+	badTLSNoKey := NewDefaultImageInspectorOptions()
+	badTLSNoKey.Image = "image"
+	badTLSNoKey.Serve = "0.0.0.0:8443"
+	badTLSNoKey.TLSCert = "types.go"
+
+	badTLSClientCANoCert := NewDefaultImageInspectorOptions()
+	badTLSClientCANoCert.Daemon = true
+	badTLSClientCANoCert.Serve = "0.0.0.0:8443"
+	badTLSClientCANoCert.TLSClientCA = "types.go"
+
+	badTLSNoSuchCert := NewDefaultImageInspectorOptions()
+	badTLSNoSuchCert.Image = "image"
+	badTLSNoSuchCert.Serve = "0.0.0.0:8443"
+	badTLSNoSuchCert.TLSCert = "/nosuchfile"
+	badTLSNoSuchCert.TLSKey = "types.go"
+
+	goodShutdown := NewDefaultImageInspectorOptions()
+	goodShutdown.Image = "image"
+	goodShutdown.Serve = "0.0.0.0:8080"
+	goodShutdown.ScanType = "openscap"
+	goodShutdown.ServeTimeout = time.Hour
+	goodShutdown.IdleTimeout = 10 * time.Minute
+	goodShutdown.ExitAfterDownloads = 1
+
+	badShutdownNoServe := NewDefaultImageInspectorOptions()
+	badShutdownNoServe.Image = "image"
+	badShutdownNoServe.IdleTimeout = 10 * time.Minute
+
+	badShutdownNegative := NewDefaultImageInspectorOptions()
+	badShutdownNegative.Image = "image"
+	badShutdownNegative.Serve = "0.0.0.0:8080"
+	badShutdownNegative.ServeTimeout = -time.Hour
+
+	badDownloadsNoScan := NewDefaultImageInspectorOptions()
+	badDownloadsNoScan.Image = "image"
+	badDownloadsNoScan.Serve = "0.0.0.0:8080"
+	badDownloadsNoScan.ExitAfterDownloads = 1
+
+	badDaemonDownloads := NewDefaultImageInspectorOptions()
+	badDaemonDownloads.Daemon = true
+	badDaemonDownloads.Serve = "0.0.0.0:8080"
+	badDaemonDownloads.ExitAfterDownloads = 1
+
+	goodWebhook := NewDefaultImageInspectorOptions()
+	goodWebhook.Daemon = true
+	goodWebhook.Serve = "0.0.0.0:8443"
+	goodWebhook.TLSCert = "types.go"
+	goodWebhook.TLSKey = "types_test.go"
+	goodWebhook.Webhook = true
+	goodWebhook.WebhookFailOpen = true
+	goodWebhook.WebhookDenySeverity = "critical"
+
+	badWebhookNoTLS := NewDefaultImageInspectorOptions()
+	badWebhookNoTLS.Daemon = true
+	badWebhookNoTLS.Serve = "0.0.0.0:8080"
+	badWebhookNoTLS.Webhook = true
+
+	badWebhookSeverity := NewDefaultImageInspectorOptions()
+	badWebhookSeverity.Daemon = true
+	badWebhookSeverity.Serve = "0.0.0.0:8443"
+	badWebhookSeverity.TLSCert = "types.go"
+	badWebhookSeverity.TLSKey = "types_test.go"
+	badWebhookSeverity.Webhook = true
+	badWebhookSeverity.WebhookDenySeverity = "severe"
+
+	badWebhookFailOpenOnly := NewDefaultImageInspectorOptions()
+	badWebhookFailOpenOnly.Daemon = true
+	badWebhookFailOpenOnly.Serve = "0.0.0.0:8080"
+	badWebhookFailOpenOnly.WebhookFailOpen = true
+
+	badWebhookNoDaemon := NewDefaultImageInspectorOptions()
+	badWebhookNoDaemon.Image = "image"
+	badWebhookNoDaemon.Serve = "0.0.0.0:8443"
+	badWebhookNoDaemon.TLSCert = "types.go"
+	badWebhookNoDaemon.TLSKey = "types_test.go"
+	badWebhookNoDaemon.Webhook = true
+
+	goodController := NewDefaultImageInspectorOptions()
+	goodController.Daemon = true
+	goodController.Serve = "0.0.0.0:8080"
+	goodController.Controller = true
+
+	badControllerInterval := NewDefaultImageInspectorOptions()
+	badControllerInterval.Daemon = true
+	badControllerInterval.Serve = "0.0.0.0:8080"
+	badControllerInterval.Controller = true
+	badControllerInterval.ControllerInterval = 0
+
+	badControllerNoDaemon := NewDefaultImageInspectorOptions()
+	badControllerNoDaemon.Image = "image"
+	badControllerNoDaemon.Controller = true
+
+	goodPublishPods := NewDefaultImageInspectorOptions()
+	goodPublishPods.Image = "image"
+	goodPublishPods.Publish = "pod"
+	goodPublishPods.PublishPods.Values = []string{"shop/web-1", "shop/web-2"}
+	goodPublishPods.PublishURL = "https://inspector.example.com"
+
+	goodPublishResource := NewDefaultImageInspectorOptions()
+	goodPublishResource.Daemon = true
+	goodPublishResource.Serve = "0.0.0.0:8080"
+	goodPublishResource.Publish = "resource"
+	goodPublishResource.PublishNamespace = "security"
+
+	goodDaemonPublishPods := NewDefaultImageInspectorOptions()
+	goodDaemonPublishPods.Daemon = true
+	goodDaemonPublishPods.Serve = "0.0.0.0:8080"
+	goodDaemonPublishPods.Controller = true
+	goodDaemonPublishPods.Publish = "pod"
+
+	goodRuntimePodman := NewDefaultImageInspectorOptions()
+	goodRuntimePodman.Image = "image"
+	goodRuntimePodman.Runtime = "podman"
+	goodRuntimePodman.RuntimeEndpoint = "unix:///run/user/1000/podman/podman.sock"
+
+	goodRuntimeCRI := NewDefaultImageInspectorOptions()
+	goodRuntimeCRI.Image = "image"
+	goodRuntimeCRI.Runtime = "cri"
+	goodRuntimeCRI.ExtractLayers = true
+
+	badRuntime := NewDefaultImageInspectorOptions()
+	badRuntime.Image = "image"
+	badRuntime.Runtime = "rkt"
+
+	badRuntimeEndpointDocker := NewDefaultImageInspectorOptions()
+	badRuntimeEndpointDocker.Image = "image"
+	badRuntimeEndpointDocker.RuntimeEndpoint = "unix:///run/podman/podman.sock"
+
+	badRuntimeCRINoLayers := NewDefaultImageInspectorOptions()
+	badRuntimeCRINoLayers.Daemon = true
+	badRuntimeCRINoLayers.Serve = "0.0.0.0:8080"
+	badRuntimeCRINoLayers.Runtime = "cri"
+	badRuntimeCRINoLayers.ExtractLayers = false
+
+	goodContainer := NewDefaultImageInspectorOptions()
+	goodContainer.Container = "web"
+	goodContainer.ScanType = "openscap"
+
+	badContainerAndImage := NewDefaultImageInspectorOptions()
+	badContainerAndImage.Image = "image"
+	badContainerAndImage.Container = "web"
+
+	badContainerMinEfficiency := NewDefaultImageInspectorOptions()
+	badContainerMinEfficiency.Container = "web"
+	badContainerMinEfficiency.MinEfficiency = 0.9
+
+	badContainerCRI := NewDefaultImageInspectorOptions()
+	badContainerCRI.Container = "web"
+	badContainerCRI.Runtime = "cri"
+
+	badDaemonContainer := NewDefaultImageInspectorOptions()
+	badDaemonContainer.Daemon = true
+	badDaemonContainer.Serve = "0.0.0.0:8080"
+	badDaemonContainer.Container = "web"
+
+	goodPlatform := NewDefaultImageInspectorOptions()
+	goodPlatform.Image = "image"
+	goodPlatform.Platform = "linux/arm/v7"
+
+	goodAllPlatforms := NewDefaultImageInspectorOptions()
+	goodAllPlatforms.Image = "image"
+	goodAllPlatforms.AllPlatforms = true
+
+	badPlatform := NewDefaultImageInspectorOptions()
+	badPlatform.Image = "image"
+	badPlatform.Platform = "arm64"
+
+	badPlatformAndAll := NewDefaultImageInspectorOptions()
+	badPlatformAndAll.Image = "image"
+	badPlatformAndAll.Platform = "linux/arm64"
+	badPlatformAndAll.AllPlatforms = true
+
+	badPlatformContainer := NewDefaultImageInspectorOptions()
+	badPlatformContainer.Container = "web"
+	badPlatformContainer.Platform = "linux/arm64"
+
+	badAllPlatformsServe := NewDefaultImageInspectorOptions()
+	badAllPlatformsServe.Image = "image"
+	badAllPlatformsServe.AllPlatforms = true
+	badAllPlatformsServe.Serve = "0.0.0.0:8080"
+
+	badDaemonPlatform := NewDefaultImageInspectorOptions()
+	badDaemonPlatform.Daemon = true
+	badDaemonPlatform.Serve = "0.0.0.0:8080"
+	badDaemonPlatform.Platform = "linux/arm64"
+
+	goodSignaturePolicy := NewDefaultImageInspectorOptions()
+	goodSignaturePolicy.Image = "image"
+	goodSignaturePolicy.SignaturePolicy = "../signature/test/policy.json"
+	goodSignaturePolicy.RequireSignatures = true
+
+	goodDaemonSignaturePolicy := NewDefaultImageInspectorOptions()
+	goodDaemonSignaturePolicy.Daemon = true
+	goodDaemonSignaturePolicy.Serve = "0.0.0.0:8080"
+	goodDaemonSignaturePolicy.SignaturePolicy = "../signature/test/policy.json"
+
+	badSignaturePolicy := NewDefaultImageInspectorOptions()
+	badSignaturePolicy.Image = "image"
+	badSignaturePolicy.SignaturePolicy = "types_test.go"
+
+	badRequireSignaturesNoPolicy := NewDefaultImageInspectorOptions()
+	badRequireSignaturesNoPolicy.Image = "image"
+	badRequireSignaturesNoPolicy.RequireSignatures = true
+
+	badSignaturePolicyContainer := NewDefaultImageInspectorOptions()
+	badSignaturePolicyContainer.Container = "web"
+	badSignaturePolicyContainer.SignaturePolicy = "../signature/test/policy.json"
+
+	badSignaturePolicyImageID := NewDefaultImageInspectorOptions()
+	badSignaturePolicyImageID.Image = "sha256:05b3abf2579a5eb66403cd78be557fd860633a1fe2103c7642030defe32c657f"
+	badSignaturePolicyImageID.SignaturePolicy = "../signature/test/policy.json"
+
+	goodAttestationKey := NewDefaultImageInspectorOptions()
+	goodAttestationKey.Image = "image"
+	goodAttestationKey.AttestationKey = "../attestation/test/attestation.key"
+
+	goodDaemonAttestationKey := NewDefaultImageInspectorOptions()
+	goodDaemonAttestationKey.Daemon = true
+	goodDaemonAttestationKey.Serve = "0.0.0.0:8080"
+	goodDaemonAttestationKey.AttestationKey = "../attestation/test/attestation.key"
+
+	badAttestationPublicKey := NewDefaultImageInspectorOptions()
+	badAttestationPublicKey.Image = "image"
+	badAttestationPublicKey.AttestationKey = "../attestation/test/attestation.pub"
+
+	badAttestationContainer := NewDefaultImageInspectorOptions()
+	badAttestationContainer.Container = "web"
+	badAttestationContainer.AttestationKey = "../attestation/test/attestation.key"
+
+	badAttestationImageID := NewDefaultImageInspectorOptions()
+	badAttestationImageID.Image = "sha256:05b3abf2579a5eb66403cd78be557fd860633a1fe2103c7642030defe32c657f"
+	badAttestationImageID.AttestationKey = "../attestation/test/attestation.key"
+
+	badPublish := NewDefaultImageInspectorOptions()
+	badPublish.Image = "image"
+	badPublish.Publish = "configmap"
+
+	badPublishNoPods := NewDefaultImageInspectorOptions()
+	badPublishNoPods.Image = "image"
+	badPublishNoPods.Publish = "pod"
+
+	badPublishPodName := NewDefaultImageInspectorOptions()
+	badPublishPodName.Image = "image"
+	badPublishPodName.Publish = "pod"
+	badPublishPodName.PublishPods.Values = []string{"web-1"}
+
+	badPublishResourceNoNamespace := NewDefaultImageInspectorOptions()
+	badPublishResourceNoNamespace.Image = "image"
+	badPublishResourceNoNamespace.Publish = "resource"
+
+	badPublishURLOnly := NewDefaultImageInspectorOptions()
+	badPublishURLOnly.Image = "image"
+	badPublishURLOnly.PublishURL = "https://inspector.example.com"
+
+	badDaemonPublishPodsNoController := NewDefaultImageInspectorOptions()
+	badDaemonPublishPodsNoController.Daemon = true
+	badDaemonPublishPodsNoController.Serve = "0.0.0.0:8080"
+	badDaemonPublishPodsNoController.Publish = "pod"
+
 	tests := map[string]struct {
 		inspector      *ImageInspectorOptions
 		shouldValidate bool
 	}{
-		"no uri":                              {inspector: noURI, shouldValidate: false},
-		"no image":                            {inspector: NewDefaultImageInspectorOptions(), shouldValidate: false},
-		"docker config and username":          {inspector: dockerCfgAndUsername, shouldValidate: false},
-		"username and no password file":       {inspector: usernameNoPasswordFile, shouldValidate: false},
-		"no serve and chroot":                 {inspector: noServeAndChroot, shouldValidate: false},
-		"good config with username":           {inspector: goodConfigUsername, shouldValidate: true},
-		"good config with docker cfg":         {inspector: goodConfigWithDockerCfg, shouldValidate: true},
-		"no scan-type with scan-dir":          {inspector: noScanTypeAndDir, shouldValidate: false},
-		"no such file dockercfg":              {inspector: noSuchFileDockercfg, shouldValidate: false},
-		"no such scan type available":         {inspector: noSuchScanType, shouldValidate: false},
-		"file exists and is not a dir":        {inspector: notADirResScan, shouldValidate: false},
-		"good config with scan options":       {inspector: goodScanOptions, shouldValidate: true},
-		"bad config with html and no scan":    {inspector: badScanOptionsHTMLnoScan, shouldValidate: false},
-		"bad config with html and wrong scan": {inspector: badScanOptionsHTMLWrongScan, shouldValidate: false},
+		"no uri":                               {inspector: noURI, shouldValidate: false},
+		"no image":                             {inspector: NewDefaultImageInspectorOptions(), shouldValidate: false},
+		"docker config and username":           {inspector: dockerCfgAndUsername, shouldValidate: false},
+		"username and no password file":        {inspector: usernameNoPasswordFile, shouldValidate: false},
+		"no serve and chroot":                  {inspector: noServeAndChroot, shouldValidate: false},
+		"good config with username":            {inspector: goodConfigUsername, shouldValidate: true},
+		"good config with docker cfg":          {inspector: goodConfigWithDockerCfg, shouldValidate: true},
+		"good reference by digest":             {inspector: goodDigestReference, shouldValidate: true},
+		"bad reference by digest":              {inspector: badDigestReference, shouldValidate: false},
+		"no scan-type with scan-dir":           {inspector: noScanTypeAndDir, shouldValidate: false},
+		"no such file dockercfg":               {inspector: noSuchFileDockercfg, shouldValidate: false},
+		"no such scan type available":          {inspector: noSuchScanType, shouldValidate: false},
+		"file exists and is not a dir":         {inspector: notADirResScan, shouldValidate: false},
+		"good config with scan options":        {inspector: goodScanOptions, shouldValidate: true},
+		"bad config with html and no scan":     {inspector: badScanOptionsHTMLnoScan, shouldValidate: false},
+		"bad config with html and wrong scan":  {inspector: badScanOptionsHTMLWrongScan, shouldValidate: false},
+		"good min efficiency":                  {inspector: goodMinEfficiency, shouldValidate: true},
+		"min efficiency without layers":        {inspector: badMinEfficiencyNoLayers, shouldValidate: false},
+		"min efficiency out of range":          {inspector: badMinEfficiencyRange, shouldValidate: false},
+		"good output":                          {inspector: goodOutput, shouldValidate: true},
+		"no such output":                       {inspector: badOutput, shouldValidate: false},
+		"output file without output":           {inspector: badOutputFileNoOutput, shouldValidate: false},
+		"good daemon":                          {inspector: goodDaemon, shouldValidate: true},
+		"daemon without serve":                 {inspector: badDaemonNoServe, shouldValidate: false},
+		"daemon with image":                    {inspector: badDaemonWithImage, shouldValidate: false},
+		"daemon with chroot":                   {inspector: badDaemonChroot, shouldValidate: false},
+		"daemon without workers":               {inspector: badDaemonNoWorkers, shouldValidate: false},
+		"good store path":                      {inspector: goodStorePath, shouldValidate: true},
+		"store path is not a dir":              {inspector: badStorePathNotADir, shouldValidate: false},
+		"daemon store path is not a dir":       {inspector: badDaemonStorePathNotADir, shouldValidate: false},
+		"good auth":                            {inspector: goodAuth, shouldValidate: true},
+		"auth without serve":                   {inspector: badAuthNoServe, shouldValidate: false},
+		"auth content users without auth":      {inspector: badAuthContentUsersOnly, shouldValidate: false},
+		"auth content user without prefix":     {inspector: badAuthContentUserNoPrefix, shouldValidate: false},
+		"auth token review without audience":   {inspector: badAuthTokenReviewNoAudience, shouldValidate: false},
+		"daemon with no such htpasswd file":    {inspector: badAuthNoSuchHtpasswd, shouldValidate: false},
+		"good tls with client ca":              {inspector: goodTLS, shouldValidate: true},
+		"tls cert without key":                 {inspector: badTLSNoKey, shouldValidate: false},
+		"tls client ca without cert":           {inspector: badTLSClientCANoCert, shouldValidate: false},
+		"no such tls cert":                     {inspector: badTLSNoSuchCert, shouldValidate: false},
+		"good shutdown":                        {inspector: goodShutdown, shouldValidate: true},
+		"idle timeout without serve":           {inspector: badShutdownNoServe, shouldValidate: false},
+		"negative serve timeout":               {inspector: badShutdownNegative, shouldValidate: false},
+		"exit after downloads without scan":    {inspector: badDownloadsNoScan, shouldValidate: false},
+		"daemon with exit after downloads":     {inspector: badDaemonDownloads, shouldValidate: false},
+		"good webhook":                         {inspector: goodWebhook, shouldValidate: true},
+		"webhook without tls":                  {inspector: badWebhookNoTLS, shouldValidate: false},
+		"no such webhook severity":             {inspector: badWebhookSeverity, shouldValidate: false},
+		"webhook fail open without webhook":    {inspector: badWebhookFailOpenOnly, shouldValidate: false},
+		"webhook without daemon":               {inspector: badWebhookNoDaemon, shouldValidate: false},
+		"good controller":                      {inspector: goodController, shouldValidate: true},
+		"controller without interval":          {inspector: badControllerInterval, shouldValidate: false},
+		"controller without daemon":            {inspector: badControllerNoDaemon, shouldValidate: false},
+		"good publish on pods":                 {inspector: goodPublishPods, shouldValidate: true},
+		"good publish of resources":            {inspector: goodPublishResource, shouldValidate: true},
+		"good daemon publish on pods":          {inspector: goodDaemonPublishPods, shouldValidate: true},
+		"no such publish option":               {inspector: badPublish, shouldValidate: false},
+		"publish on pods without pods":         {inspector: badPublishNoPods, shouldValidate: false},
+		"publish on a pod without namespace":   {inspector: badPublishPodName, shouldValidate: false},
+		"publish resources without namespace":  {inspector: badPublishResourceNoNamespace, shouldValidate: false},
+		"publish url without publish":          {inspector: badPublishURLOnly, shouldValidate: false},
+		"daemon publish on pods no controller": {inspector: badDaemonPublishPodsNoController, shouldValidate: false},
+		"good podman runtime":                  {inspector: goodRuntimePodman, shouldValidate: true},
+		"good cri runtime":                     {inspector: goodRuntimeCRI, shouldValidate: true},
+		"no such runtime":                      {inspector: badRuntime, shouldValidate: false},
+		"runtime endpoint with docker":         {inspector: badRuntimeEndpointDocker, shouldValidate: false},
+		"cri runtime without layers":           {inspector: badRuntimeCRINoLayers, shouldValidate: false},
+		"good container":                       {inspector: goodContainer, shouldValidate: true},
+		"container and image":                  {inspector: badContainerAndImage, shouldValidate: false},
+		"container min efficiency":             {inspector: badContainerMinEfficiency, shouldValidate: false},
+		"container with the cri runtime":       {inspector: badContainerCRI, shouldValidate: false},
+		"daemon container":                     {inspector: badDaemonContainer, shouldValidate: false},
+		"good platform":                        {inspector: goodPlatform, shouldValidate: true},
+		"good all platforms":                   {inspector: goodAllPlatforms, shouldValidate: true},
+		"platform without os":                  {inspector: badPlatform, shouldValidate: false},
+		"platform and all platforms":           {inspector: badPlatformAndAll, shouldValidate: false},
+		"platform of a container":              {inspector: badPlatformContainer, shouldValidate: false},
+		"all platforms served":                 {inspector: badAllPlatformsServe, shouldValidate: false},
+		"daemon platform":                      {inspector: badDaemonPlatform, shouldValidate: false},
+		"good signature policy":                {inspector: goodSignaturePolicy, shouldValidate: true},
+		"good daemon signature policy":         {inspector: goodDaemonSignaturePolicy, shouldValidate: true},
+		"invalid signature policy":             {inspector: badSignaturePolicy, shouldValidate: false},
+		"require signatures without policy":    {inspector: badRequireSignaturesNoPolicy, shouldValidate: false},
+		"signature policy of a container":      {inspector: badSignaturePolicyContainer, shouldValidate: false},
+		"signature policy of an image id":      {inspector: badSignaturePolicyImageID, shouldValidate: false},
+		"good attestation key":                 {inspector: goodAttestationKey, shouldValidate: true},
+		"good daemon attestation key":          {inspector: goodDaemonAttestationKey, shouldValidate: true},
+		"public attestation key":               {inspector: badAttestationPublicKey, shouldValidate: false},
+		"attestation of a container":           {inspector: badAttestationContainer, shouldValidate: false},
+		"attestation of an image id":           {inspector: badAttestationImageID, shouldValidate: false},
 	}
 
 	for k, v := range tests {
@@ -0,0 +1,327 @@
+// Package controller discovers the images run by the pods of the cluster and
+// submits their inspections.
+package controller
+
+import (
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+const (
+	// UNUSED_IMAGE_GRACE is how long an image no pod runs anymore is kept
+	// before it's forgotten
+	UNUSED_IMAGE_GRACE = 10 * time.Minute
+	// MAX_RETRY_BACKOFF is the longest time waited before inspecting again
+	// an image whose inspection failed
+	MAX_RETRY_BACKOFF = time.Hour
+)
+
+// imageIDSchemes are the prefixes the container runtimes add to the image
+// ids of the container statuses.
+var imageIDSchemes = []string{"docker-pullable://", "docker://", "containerd://", "cri-o://"}
+
+// Inspections runs the inspections submitted by the controller, it's
+// implemented by the queue of the inspection service.
+type Inspections interface {
+	Submit(req iiapi.InspectionRequest) (iiapi.Inspection, error)
+	Get(id string) (iiapi.Inspection, *iiapi.InspectorReport, string, bool)
+}
+
+// discoveredImage is an image found by the controller.
+type discoveredImage struct {
+	image        iiapi.DiscoveredImage
+	inspectionID string
+	pods         map[string]bool
+	// unused is when the last pod running the image went away
+	unused time.Time
+	// retrying is true when the inspection failed and is queued again
+	retrying bool
+}
+
+// Controller discovers the images run by the pods of the cluster, watched
+// with a shared informer, and inspects each of them once. The images are
+// told apart by the image id of the container statuses, so that an image is
+// inspected once whatever the tags it's run with. The image ids are queued
+// in a rate limited work queue and the inspections are submitted one at a
+// time, at most one per interval. The failed inspections are queued again
+// with the exponential backoff of the queue and the images no pod runs
+// anymore are forgotten after a grace period.
+type Controller struct {
+	inspections Inspections
+	scanTypes   []string
+	interval    time.Duration
+	// grace is how long the images no pod runs are kept
+	grace time.Duration
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	lock sync.Mutex
+	// images are the discovered images by image id
+	images map[string]*discoveredImage
+	// podImages are the image ids run by each pod
+	podImages map[string][]string
+}
+
+// NewController returns a controller submitting the inspections of the
+// discovered images with scanTypes to inspections, waiting interval
+// between two submissions.
+func NewController(inspections Inspections, scanTypes []string, interval time.Duration) *Controller {
+	return &Controller{
+		inspections: inspections,
+		scanTypes:   scanTypes,
+		interval:    interval,
+		grace:       UNUSED_IMAGE_GRACE,
+		queue: workqueue.NewTypedRateLimitingQueue[string](
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](interval, MAX_RETRY_BACKOFF)),
+		images:    map[string]*discoveredImage{},
+		podImages: map[string][]string{},
+	}
+}
+
+// Run watches the pods of all the namespaces with client and submits the
+// inspections of their images until stop is closed, checking every interval
+// for the failed inspections and the unused images.
+func (c *Controller) Run(client kubernetes.Interface, stop <-chan struct{}) {
+	defer c.queue.ShutDown()
+	factory := informers.NewSharedInformerFactory(client, 0)
+	pods := factory.Core().V1().Pods().Informer()
+	pods.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addPod,
+		UpdateFunc: func(_, obj interface{}) { c.addPod(obj) },
+		DeleteFunc: c.deletePod,
+	})
+	factory.Start(stop)
+	if !cache.WaitForCacheSync(stop, pods.HasSynced) {
+		return
+	}
+
+	go func() {
+		for c.submitNext() {
+			select {
+			case <-stop:
+				return
+			case <-time.After(c.interval):
+			}
+		}
+	}()
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			c.resync(time.Now())
+		}
+	}
+}
+
+// submitNext waits for the next queued image and submits its inspection,
+// it returns false once the queue is shut down. The images that can't be
+// submitted, e.g. because the inspection queue is full, are queued again
+// with a backoff.
+func (c *Controller) submitNext() bool {
+	id, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(id)
+
+	c.lock.Lock()
+	discovered, ok := c.images[id]
+	if !ok || (len(discovered.inspectionID) > 0 && !discovered.retrying) {
+		// forgotten or already inspected
+		c.lock.Unlock()
+		return true
+	}
+	image := discovered.image.Image
+	c.lock.Unlock()
+
+	inspection, err := c.inspections.Submit(iiapi.InspectionRequest{Image: image, ScanTypes: c.scanTypes})
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if err != nil {
+		log.Printf("WARNING: Unable to submit the inspection of %s, trying again later: %v", image, err)
+		c.queue.AddRateLimited(id)
+		return true
+	}
+	if discovered, ok := c.images[id]; ok {
+		discovered.inspectionID = inspection.ID
+		discovered.retrying = false
+		log.Printf("Submitted inspection %s of %s run by %d pods", inspection.ID, image, len(discovered.pods))
+	}
+	return true
+}
+
+// resync forgets the images unused for longer than the grace period and
+// queues again, with a backoff, the images whose inspection failed.
+func (c *Controller) resync(now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for id, image := range c.images {
+		if len(image.pods) == 0 && now.Sub(image.unused) >= c.grace {
+			log.Printf("Forgetting %s, no pod has run it for %v", image.image.Image, c.grace)
+			delete(c.images, id)
+			c.queue.Forget(id)
+			continue
+		}
+		if image.retrying || len(image.inspectionID) == 0 {
+			continue
+		}
+		inspection, _, _, ok := c.inspections.Get(image.inspectionID)
+		switch {
+		case !ok:
+		case inspection.Status == iiapi.InspectionSucceeded:
+			c.queue.Forget(id)
+		case inspection.Status == iiapi.InspectionFailed && len(image.pods) > 0:
+			image.retrying = true
+			c.queue.AddRateLimited(id)
+			log.Printf("Inspection %s of %s failed %d times, trying again later", inspection.ID, image.image.Image, c.queue.NumRequeues(id))
+		}
+	}
+}
+
+// addPod records the images of a pod added or updated.
+func (c *Controller) addPod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.update(pod)
+}
+
+// deletePod forgets a deleted pod, its images are still known for the grace
+// period.
+func (c *Controller) deletePod(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.forget(podKey(pod))
+}
+
+func (c *Controller) update(pod *corev1.Pod) {
+	key := podKey(pod)
+	c.forget(key)
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if len(status.ImageID) == 0 {
+			// not pulled yet
+			continue
+		}
+		reference, id := imageReference(status)
+		image, ok := c.images[id]
+		if !ok {
+			image = &discoveredImage{
+				image: iiapi.DiscoveredImage{Image: reference, ImageID: id},
+				pods:  map[string]bool{},
+			}
+			c.images[id] = image
+			c.queue.Add(id)
+		}
+		image.pods[key] = true
+		image.unused = time.Time{}
+		c.podImages[key] = append(c.podImages[key], id)
+	}
+}
+
+// forget removes the pod key from its images, recording when they became
+// unused.
+func (c *Controller) forget(key string) {
+	for _, id := range c.podImages[key] {
+		image, ok := c.images[id]
+		if !ok {
+			continue
+		}
+		delete(image.pods, key)
+		if len(image.pods) == 0 {
+			image.unused = time.Now()
+		}
+	}
+	delete(c.podImages, key)
+}
+
+// Images returns the images discovered so far that are still run by some
+// pods, with their inspection.
+func (c *Controller) Images() []iiapi.DiscoveredImage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	images := []iiapi.DiscoveredImage{}
+	for _, image := range c.images {
+		if len(image.pods) == 0 {
+			continue
+		}
+		discovered := image.image
+		discovered.Pods = make([]string, 0, len(image.pods))
+		for pod := range image.pods {
+			discovered.Pods = append(discovered.Pods, pod)
+		}
+		sort.Strings(discovered.Pods)
+		if len(image.inspectionID) > 0 {
+			if inspection, _, _, ok := c.inspections.Get(image.inspectionID); ok {
+				discovered.Inspection = &inspection
+			}
+		}
+		images = append(images, discovered)
+	}
+	sort.Slice(images, func(i, j int) bool {
+		return images[i].Image < images[j].Image
+	})
+	return images
+}
+
+// Pods returns the pods running the discovered image whose reference is
+// image, as namespace/name.
+func (c *Controller) Pods(image string) []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	pods := []string{}
+	for _, discovered := range c.images {
+		if discovered.image.Image != image {
+			continue
+		}
+		for pod := range discovered.pods {
+			pods = append(pods, pod)
+		}
+	}
+	sort.Strings(pods)
+	return pods
+}
+
+// podKey returns the namespace/name of pod.
+func podKey(pod *corev1.Pod) string {
+	return pod.Namespace + "/" + pod.Name
+}
+
+// imageReference returns the reference to inspect for the image run by the
+// container of status and the id of the image. The image ids which are a
+// reference by digest are inspected as they are, the other ones, only known
+// by the node, are inspected by the reference of the container.
+func imageReference(status corev1.ContainerStatus) (string, string) {
+	id := status.ImageID
+	for _, scheme := range imageIDSchemes {
+		id = strings.TrimPrefix(id, scheme)
+	}
+	if strings.Contains(id, "@") {
+		return id, id
+	}
+	return status.Image, id
+}
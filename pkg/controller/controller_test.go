@@ -0,0 +1,213 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+const (
+	fedoraDigest = "docker.io/library/fedora@sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	nginxDigest  = "docker.io/library/nginx@sha256:2222222222222222222222222222222222222222222222222222222222222222"
+	localID      = "sha256:3333333333333333333333333333333333333333333333333333333333333333"
+)
+
+// fakeInspections records the submitted inspections, refusing the first
+// ones when full is positive. The inspections of the failed images fail.
+type fakeInspections struct {
+	lock      sync.Mutex
+	full      int
+	failed    map[string]bool
+	submitted []iiapi.InspectionRequest
+}
+
+func (f *fakeInspections) Submit(req iiapi.InspectionRequest) (iiapi.Inspection, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.full > 0 {
+		f.full--
+		return iiapi.Inspection{}, fmt.Errorf("The inspection queue is full")
+	}
+	f.submitted = append(f.submitted, req)
+	return iiapi.Inspection{ID: fmt.Sprint(len(f.submitted)), Image: req.Image}, nil
+}
+
+func (f *fakeInspections) Get(id string) (iiapi.Inspection, *iiapi.InspectorReport, string, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	for idx, req := range f.submitted {
+		if fmt.Sprint(idx+1) == id && f.failed[req.Image] {
+			return iiapi.Inspection{ID: id, Image: req.Image, Status: iiapi.InspectionFailed}, nil, "", true
+		}
+		if fmt.Sprint(idx+1) == id {
+			return iiapi.Inspection{ID: id, Image: req.Image, Status: iiapi.InspectionSucceeded, ResultID: "result-" + id}, nil, "", true
+		}
+	}
+	return iiapi.Inspection{}, nil, "", false
+}
+
+func (f *fakeInspections) images() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	images := []string{}
+	for _, req := range f.submitted {
+		images = append(images, req.Image)
+	}
+	return images
+}
+
+func newPod(namespace, name string, statuses ...corev1.ContainerStatus) *corev1.Pod {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	pod.Status.ContainerStatuses = statuses
+	return pod
+}
+
+// waitForImages waits for the inspections of the expected images, in any
+// order as the informer notifies the pods in any order.
+func waitForImages(t *testing.T, inspections *fakeInspections, expected []string) {
+	sort.Strings(expected)
+	for i := 0; i < 500; i++ {
+		images := inspections.images()
+		sort.Strings(images)
+		if fmt.Sprint(images) == fmt.Sprint(expected) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Expected the inspections of %v but got %v", expected, inspections.images())
+}
+
+func TestController(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		newPod("shop", "web-1",
+			corev1.ContainerStatus{Name: "web", Image: "fedora:latest", ImageID: "docker-pullable://" + fedoraDigest},
+			corev1.ContainerStatus{Name: "proxy", Image: "nginx:1.13", ImageID: "docker-pullable://" + nginxDigest}),
+		// the same image by another tag is inspected once
+		newPod("shop", "web-2",
+			corev1.ContainerStatus{Name: "web", Image: "fedora:26", ImageID: "docker-pullable://" + fedoraDigest}),
+		// not pulled yet
+		newPod("shop", "web-3", corev1.ContainerStatus{Name: "web", Image: "centos:7"}),
+	)
+	inspections := &fakeInspections{full: 1}
+	c := NewController(inspections, []string{"openscap"}, 10*time.Millisecond)
+	stop := make(chan struct{})
+	defer close(stop)
+	go c.Run(client, stop)
+
+	// the first submission fails and is tried again
+	waitForImages(t, inspections, []string{fedoraDigest, nginxDigest})
+
+	ctx := context.Background()
+	local := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "tools", Name: "local"},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{{Name: "init", Image: "fedora:latest", ImageID: "containerd://" + fedoraDigest}},
+			ContainerStatuses:     []corev1.ContainerStatus{{Name: "build", Image: "builder:dev", ImageID: "docker://" + localID}},
+		},
+	}
+	if _, err := client.CoreV1().Pods("tools").Create(ctx, local, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("Unable to create the pod: %v", err)
+	}
+	waitForImages(t, inspections, []string{fedoraDigest, nginxDigest, "builder:dev"})
+
+	if err := client.CoreV1().Pods("shop").Delete(ctx, "web-1", metav1.DeleteOptions{}); err != nil {
+		t.Fatalf("Unable to delete the pod: %v", err)
+	}
+	for i := 0; i < 500 && len(c.Images()) > 2; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if pods := c.Pods(fedoraDigest); fmt.Sprint(pods) != "[shop/web-2 tools/local]" {
+		t.Errorf("Unexpected pods running %s: %v", fedoraDigest, pods)
+	}
+	images := c.Images()
+	expected := []struct {
+		image, id string
+		pods      []string
+	}{
+		{"builder:dev", localID, []string{"tools/local"}},
+		{fedoraDigest, fedoraDigest, []string{"shop/web-2", "tools/local"}},
+	}
+	if len(images) != len(expected) {
+		t.Fatalf("Expected %d images but got %+v", len(expected), images)
+	}
+	for idx, image := range images {
+		if image.Image != expected[idx].image || image.ImageID != expected[idx].id || fmt.Sprint(image.Pods) != fmt.Sprint(expected[idx].pods) {
+			t.Errorf("Expected %+v but got %+v", expected[idx], image)
+		}
+		if image.Inspection == nil || image.Inspection.Status != iiapi.InspectionSucceeded {
+			t.Errorf("Expected the inspection of %s but got %+v", image.Image, image.Inspection)
+		}
+	}
+}
+
+func TestControllerRateLimit(t *testing.T) {
+	client := fake.NewSimpleClientset(newPod("shop", "web",
+		corev1.ContainerStatus{Name: "web", Image: "fedora:latest", ImageID: "docker-pullable://" + fedoraDigest},
+		corev1.ContainerStatus{Name: "proxy", Image: "nginx:1.13", ImageID: "docker-pullable://" + nginxDigest}))
+	inspections := &fakeInspections{}
+	c := NewController(inspections, []string{"openscap"}, time.Hour)
+	stop := make(chan struct{})
+	defer close(stop)
+	go c.Run(client, stop)
+
+	waitForImages(t, inspections, []string{fedoraDigest})
+	time.Sleep(50 * time.Millisecond)
+	if images := inspections.images(); len(images) != 1 {
+		t.Errorf("Only one inspection should have been submitted within the interval but got %v", images)
+	}
+	images := c.Images()
+	if len(images) != 2 || images[0].Inspection == nil || images[1].Inspection != nil {
+		t.Errorf("Expected the inspection of %s to be waiting but got %+v", nginxDigest, images)
+	}
+}
+
+func TestControllerResync(t *testing.T) {
+	inspections := &fakeInspections{failed: map[string]bool{nginxDigest: true}}
+	c := NewController(inspections, []string{"openscap"}, 10*time.Millisecond)
+	c.grace = time.Hour
+	c.addPod(newPod("shop", "web",
+		corev1.ContainerStatus{Name: "web", Image: "fedora:latest", ImageID: "docker-pullable://" + fedoraDigest},
+		corev1.ContainerStatus{Name: "proxy", Image: "nginx:1.13", ImageID: "docker-pullable://" + nginxDigest}))
+	c.submitNext()
+	c.submitNext()
+
+	// the failed inspection is queued again with a backoff
+	c.resync(time.Now())
+	if c.queue.NumRequeues(nginxDigest) != 1 || c.queue.NumRequeues(fedoraDigest) != 0 || c.queue.Len() != 0 {
+		t.Fatalf("The failed inspection should wait for its backoff but got %d requeues", c.queue.NumRequeues(nginxDigest))
+	}
+	// checking again before it's submitted doesn't queue it twice
+	c.resync(time.Now())
+	if c.queue.NumRequeues(nginxDigest) != 1 {
+		t.Errorf("The failed inspection should be queued once but got %d requeues", c.queue.NumRequeues(nginxDigest))
+	}
+	c.submitNext()
+	c.resync(time.Now())
+	if c.queue.NumRequeues(nginxDigest) != 2 {
+		t.Errorf("The backoff should have grown but got %d requeues", c.queue.NumRequeues(nginxDigest))
+	}
+	if images := inspections.images(); len(images) != 3 || images[2] != nginxDigest {
+		t.Errorf("Expected the failed inspection to be submitted again but got %v", images)
+	}
+
+	// the images without pods are forgotten after the grace period
+	c.deletePod(newPod("shop", "web"))
+	c.resync(time.Now())
+	if len(c.images) != 2 {
+		t.Errorf("The unused images should be kept during the grace period but got %v", c.images)
+	}
+	c.resync(time.Now().Add(time.Hour))
+	if len(c.images) != 0 || c.queue.NumRequeues(nginxDigest) != 0 {
+		t.Errorf("The unused images should have been forgotten but got %v", c.images)
+	}
+}
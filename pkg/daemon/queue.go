@@ -0,0 +1,341 @@
+package daemon
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+	"github.com/openshift/image-inspector/pkg/store"
+)
+
+const (
+	CONTENT_DIR = "content"
+	RESULTS_DIR = "results"
+)
+
+// ErrQueueFull is returned when an inspection is submitted to a full queue.
+var ErrQueueFull = fmt.Errorf("The inspection queue is full")
+
+// RunFunc runs an inspection with the given options and returns its outcome.
+type RunFunc func(opts iicmd.ImageInspectorOptions) (*iiapi.InspectorReport, error)
+
+// SucceededFunc is called with each inspection that succeeded and its report.
+type SucceededFunc func(inspection iiapi.Inspection, report *iiapi.InspectorReport)
+
+// inspectionJob is an inspection tracked by the queue.
+type inspectionJob struct {
+	inspection iiapi.Inspection
+	opts       iicmd.ImageInspectorOptions
+	report     *iiapi.InspectorReport
+}
+
+// InspectionQueue runs the submitted inspections with a bounded number of
+// workers and keeps track of their status and results.
+type InspectionQueue struct {
+	// opts are the options shared by all the inspections
+	opts iicmd.ImageInspectorOptions
+	// workDir is the directory where the inspections are extracted
+	workDir string
+	// results records the results of the inspections
+	results store.ResultStore
+	run     RunFunc
+	queue   chan *inspectionJob
+	// succeeded is called after each inspection that succeeded, if not nil
+	succeeded SucceededFunc
+
+	lock sync.RWMutex
+	jobs map[string]*inspectionJob
+}
+
+// NewInspectionQueue returns a queue running the inspections with run, in
+// directories created below workDir, and recording their results in
+// results. opts provides the shared options, the number of workers and the
+// size of the queue.
+func NewInspectionQueue(opts iicmd.ImageInspectorOptions, workDir string, results store.ResultStore, run RunFunc) *InspectionQueue {
+	return &InspectionQueue{
+		opts:    opts,
+		workDir: workDir,
+		results: results,
+		run:     run,
+		queue:   make(chan *inspectionJob, opts.QueueSize),
+		jobs:    map[string]*inspectionJob{},
+	}
+}
+
+// OnSucceeded makes the queue call fn with each inspection that succeeded,
+// it must be called before Start.
+func (q *InspectionQueue) OnSucceeded(fn SucceededFunc) {
+	q.succeeded = fn
+}
+
+// Start starts the workers of the queue.
+func (q *InspectionQueue) Start() {
+	for w := 0; w < q.opts.Workers; w++ {
+		go func() {
+			for job := range q.queue {
+				q.runJob(job)
+			}
+		}()
+	}
+}
+
+// Submit validates and queues the inspection requested by req, evicting
+// the finished inspections beyond the retention limits.
+func (q *InspectionQueue) Submit(req iiapi.InspectionRequest) (iiapi.Inspection, error) {
+	if len(req.Image) == 0 {
+		return iiapi.Inspection{}, fmt.Errorf("Docker image to inspect must be specified")
+	}
+	if len(req.ScanTypes) > 1 {
+		return iiapi.Inspection{}, fmt.Errorf("Only one scan type can be requested")
+	}
+	opts := q.opts
+	opts.Daemon = false
+	// the inspections aren't served, the service is
+	opts.Serve = ""
+	opts.TLSCert, opts.TLSKey, opts.TLSClientCA = "", "", ""
+	opts.AuthTokenFile, opts.AuthHtpasswdFile, opts.AuthTokenReview = "", "", false
+	opts.AuthContentUsers = iicmd.MultiStringVar{}
+	opts.ServeTimeout, opts.IdleTimeout = 0, 0
+	opts.Webhook, opts.WebhookFailOpen = false, false
+	opts.Controller = false
+	// the summaries are published by the service
+	opts.Publish, opts.PublishNamespace, opts.PublishURL = "", "", ""
+	opts.PublishPods = iicmd.MultiStringVar{}
+	// the results are recorded by the queue
+	opts.StorePath = ""
+	opts.Image = req.Image
+	if len(req.ScanTypes) > 0 {
+		opts.ScanType = req.ScanTypes[0]
+	} else {
+		opts.OpenScapHTML = false
+	}
+	if err := opts.Validate(); err != nil {
+		return iiapi.Inspection{}, err
+	}
+
+	id, err := generateInspectionID()
+	if err != nil {
+		return iiapi.Inspection{}, err
+	}
+	job := &inspectionJob{
+		inspection: iiapi.Inspection{
+			ID:        id,
+			Image:     req.Image,
+			ScanTypes: req.ScanTypes,
+			Status:    iiapi.InspectionQueued,
+			Submitted: time.Now(),
+		},
+		opts: opts,
+	}
+	if job.inspection.ScanTypes == nil {
+		job.inspection.ScanTypes = []string{}
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.evict()
+	if q.reuseResult(job) {
+		q.jobs[id] = job
+		log.Printf("Reused the scan result %s for inspection %s of %s", job.inspection.ResultID, id, req.Image)
+		return job.inspection, nil
+	}
+	select {
+	case q.queue <- job:
+	default:
+		return iiapi.Inspection{}, ErrQueueFull
+	}
+	q.jobs[id] = job
+	log.Printf("Queued inspection %s of %s", id, req.Image)
+	return job.inspection, nil
+}
+
+// reuseResult completes job with the most recent stored result of the same scans
+// of its image, if any. Only the references by digest are looked up since
+// tags may have moved since the previous scan. The content of the image is
+// not extracted again.
+func (q *InspectionQueue) reuseResult(job *inspectionJob) bool {
+	if !store.IsDigestReference(job.inspection.Image) {
+		return false
+	}
+	history, err := q.results.History(job.inspection.Image)
+	if err != nil {
+		return false
+	}
+	var result *iiapi.ScanResult
+	for idx := range history {
+		if sameScanTypes(history[idx].ScanTypes, job.inspection.ScanTypes) {
+			result = &history[idx]
+			break
+		}
+	}
+	if result == nil {
+		return false
+	}
+	job.inspection.Status = iiapi.InspectionSucceeded
+	job.inspection.Started = &result.Started
+	job.inspection.Finished = &result.Finished
+	job.inspection.ResultID = result.ID
+	job.inspection.Reused = true
+	job.report = result.Report()
+	return true
+}
+
+func sameScanTypes(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if a[idx] != b[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// runJob runs the inspection of job updating its status.
+func (q *InspectionQueue) runJob(job *inspectionJob) {
+	jobDir := path.Join(q.workDir, job.inspection.ID)
+	q.update(job, func() {
+		now := time.Now()
+		job.inspection.Status = iiapi.InspectionRunning
+		job.inspection.Started = &now
+		job.opts.DstPath = path.Join(jobDir, CONTENT_DIR)
+		if len(job.opts.ScanType) > 0 {
+			job.opts.ScanResultsDir = path.Join(jobDir, RESULTS_DIR)
+		}
+	})
+
+	var report *iiapi.InspectorReport
+	var result *iiapi.ScanResult
+	err := os.Mkdir(jobDir, 0700)
+	if err == nil {
+		q.lock.RLock()
+		opts := job.opts
+		started := *job.inspection.Started
+		scanTypes := job.inspection.ScanTypes
+		q.lock.RUnlock()
+		if report, err = q.run(opts); err == nil {
+			result = store.NewScanResult(report, scanTypes, started, time.Now())
+			if storeErr := q.results.Put(result); storeErr != nil {
+				log.Printf("WARNING: Unable to record the result of inspection %s: %v", job.inspection.ID, storeErr)
+				result = nil
+			}
+		}
+	}
+
+	q.update(job, func() {
+		now := time.Now()
+		job.inspection.Finished = &now
+		if result != nil {
+			job.inspection.ResultID = result.ID
+		}
+		if err != nil {
+			job.inspection.Status = iiapi.InspectionFailed
+			job.inspection.ErrorMessage = err.Error()
+			log.Printf("Inspection %s of %s failed: %v", job.inspection.ID, job.inspection.Image, err)
+			return
+		}
+		job.inspection.Status = iiapi.InspectionSucceeded
+		job.report = report
+		log.Printf("Inspection %s of %s succeeded", job.inspection.ID, job.inspection.Image)
+	})
+	if err == nil && q.succeeded != nil {
+		inspection, _, _, _ := q.Get(job.inspection.ID)
+		q.succeeded(inspection, report)
+	}
+	q.update(job, q.evict)
+}
+
+// evict forgets the finished inspections beyond the InspectionRetention
+// most recent ones or finished for longer than InspectionTTL, removing
+// their directory. Their result stays in the store. The queue lock must be
+// held.
+func (q *InspectionQueue) evict() {
+	finished := []*inspectionJob{}
+	for _, job := range q.jobs {
+		if job.inspection.Finished != nil {
+			finished = append(finished, job)
+		}
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].inspection.Finished.After(*finished[j].inspection.Finished)
+	})
+	for idx, job := range finished {
+		expired := q.opts.InspectionTTL > 0 && time.Since(*job.inspection.Finished) > q.opts.InspectionTTL
+		if idx < q.opts.InspectionRetention && !expired {
+			continue
+		}
+		if err := os.RemoveAll(path.Join(q.workDir, job.inspection.ID)); err != nil {
+			log.Printf("WARNING: Unable to remove the content of inspection %s: %v", job.inspection.ID, err)
+			continue
+		}
+		delete(q.jobs, job.inspection.ID)
+		log.Printf("Evicted inspection %s of %s", job.inspection.ID, job.inspection.Image)
+	}
+}
+
+// update runs fn holding the queue lock.
+func (q *InspectionQueue) update(job *inspectionJob, fn func()) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	fn()
+}
+
+// Get returns the inspection id, its report and the path of its content.
+// The report is nil until the inspection succeeded.
+func (q *InspectionQueue) Get(id string) (iiapi.Inspection, *iiapi.InspectorReport, string, bool) {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	job, ok := q.jobs[id]
+	if !ok {
+		return iiapi.Inspection{}, nil, "", false
+	}
+	return job.inspection, job.report, job.opts.DstPath, true
+}
+
+// List returns all the inspections, the most recently submitted first.
+func (q *InspectionQueue) List() []iiapi.Inspection {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	inspections := make([]iiapi.Inspection, 0, len(q.jobs))
+	for _, job := range q.jobs {
+		inspections = append(inspections, job.inspection)
+	}
+	sort.Slice(inspections, func(i, j int) bool {
+		return inspections[i].Submitted.After(inspections[j].Submitted)
+	})
+	return inspections
+}
+
+// Cleanup removes the directories of all the inspections of the queue.
+func (q *InspectionQueue) Cleanup() error {
+	q.lock.RLock()
+	defer q.lock.RUnlock()
+	var failed []string
+	for id := range q.jobs {
+		if err := os.RemoveAll(path.Join(q.workDir, id)); err != nil {
+			failed = append(failed, err.Error())
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("Unable to remove the inspections: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func generateInspectionID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("Unable to generate inspection id: %v\n", err)
+	}
+	return hex.EncodeToString(id), nil
+}
@@ -0,0 +1,236 @@
+package daemon
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+	"github.com/openshift/image-inspector/pkg/store"
+)
+
+func newTestQueue(t *testing.T, run RunFunc) (*InspectionQueue, func()) {
+	return newTestQueueWithStore(t, store.NewMemoryStore(), run)
+}
+
+func newTestQueueWithStore(t *testing.T, results store.ResultStore, run RunFunc) (*InspectionQueue, func()) {
+	workDir, err := ioutil.TempDir("", "inspections-")
+	if err != nil {
+		t.Fatalf("Unable to create work dir: %v", err)
+	}
+	opts := iicmd.NewDefaultImageInspectorOptions()
+	opts.Workers = 1
+	opts.QueueSize = 1
+	return NewInspectionQueue(*opts, workDir, results, run), func() { os.RemoveAll(workDir) }
+}
+
+func waitFor(t *testing.T, q *InspectionQueue, id string, status iiapi.InspectionStatus) iiapi.Inspection {
+	for i := 0; i < 100; i++ {
+		inspection, _, _, ok := q.Get(id)
+		if ok && inspection.Status == status {
+			return inspection
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("Inspection %s never reached status %s", id, status)
+	return iiapi.Inspection{}
+}
+
+func TestInspectionQueue(t *testing.T) {
+	release := make(chan struct{})
+	q, cleanup := newTestQueue(t, func(opts iicmd.ImageInspectorOptions) (*iiapi.InspectorReport, error) {
+		<-release
+		if opts.Image == "failing" {
+			return nil, fmt.Errorf("FAIL!")
+		}
+		if opts.ScanType != "openscap" || len(opts.ScanResultsDir) == 0 || len(opts.DstPath) == 0 {
+			return nil, fmt.Errorf("unexpected options %+v", opts)
+		}
+		return &iiapi.InspectorReport{Image: opts.Image}, nil
+	})
+	defer cleanup()
+	q.Start()
+
+	for k, req := range map[string]iiapi.InspectionRequest{
+		"no image":         {},
+		"unknown scan":     {Image: "image", ScanTypes: []string{"nosuchscan"}},
+		"many scan types":  {Image: "image", ScanTypes: []string{"openscap", "openscap"}},
+		"image with space": {Image: "", ScanTypes: []string{"openscap"}},
+	} {
+		if _, err := q.Submit(req); err == nil {
+			t.Errorf("%s should have failed", k)
+		}
+	}
+
+	good, err := q.Submit(iiapi.InspectionRequest{Image: "fedora:22", ScanTypes: []string{"openscap"}})
+	if err != nil {
+		t.Fatalf("Unable to submit an inspection: %v", err)
+	}
+	waitFor(t, q, good.ID, iiapi.InspectionRunning)
+	failing, err := q.Submit(iiapi.InspectionRequest{Image: "failing", ScanTypes: []string{"openscap"}})
+	if err != nil {
+		t.Fatalf("Unable to submit an inspection: %v", err)
+	}
+	if _, err := q.Submit(iiapi.InspectionRequest{Image: "fedora:23"}); err != ErrQueueFull {
+		t.Errorf("The queue should have been full but got %v", err)
+	}
+	close(release)
+
+	waitFor(t, q, good.ID, iiapi.InspectionSucceeded)
+	_, report, contentPath, _ := q.Get(good.ID)
+	if report == nil || report.Image != "fedora:22" || len(contentPath) == 0 {
+		t.Errorf("Unexpected report %v and content path %q", report, contentPath)
+	}
+	inspection := waitFor(t, q, failing.ID, iiapi.InspectionFailed)
+	if inspection.ErrorMessage != "FAIL!" || inspection.Finished == nil {
+		t.Errorf("Unexpected failed inspection %+v", inspection)
+	}
+
+	if list := q.List(); len(list) != 2 || list[0].ID != failing.ID {
+		t.Errorf("Unexpected inspections list %+v", list)
+	}
+	if _, _, _, ok := q.Get("nosuchid"); ok {
+		t.Errorf("An unknown inspection should not be found")
+	}
+}
+
+func TestInspectionQueueReusesResults(t *testing.T) {
+	results := store.NewMemoryStore()
+	q, cleanup := newTestQueueWithStore(t, results, func(opts iicmd.ImageInspectorOptions) (*iiapi.InspectorReport, error) {
+		meta := &iiapi.InspectorMetadata{}
+		meta.ID = "sha256:1234"
+		meta.RepoDigests = []string{strings.Replace(opts.Image, ":22", "@sha256:abcd", 1)}
+		return &iiapi.InspectorReport{Image: opts.Image, Metadata: meta}, nil
+	})
+	defer cleanup()
+	q.Start()
+
+	first, err := q.Submit(iiapi.InspectionRequest{Image: "fedora:22", ScanTypes: []string{"openscap"}})
+	if err != nil {
+		t.Fatalf("Unable to submit an inspection: %v", err)
+	}
+	first = waitFor(t, q, first.ID, iiapi.InspectionSucceeded)
+	if len(first.ResultID) == 0 {
+		t.Fatalf("The result of the inspection should have been stored")
+	}
+
+	for k, test := range map[string]struct {
+		req    iiapi.InspectionRequest
+		reused bool
+	}{
+		"digest":             {iiapi.InspectionRequest{Image: "fedora@sha256:abcd", ScanTypes: []string{"openscap"}}, true},
+		"image id":           {iiapi.InspectionRequest{Image: "sha256:1234", ScanTypes: []string{"openscap"}}, true},
+		"tag":                {iiapi.InspectionRequest{Image: "fedora:22", ScanTypes: []string{"openscap"}}, false},
+		"other scan types":   {iiapi.InspectionRequest{Image: "fedora@sha256:abcd"}, false},
+		"other image digest": {iiapi.InspectionRequest{Image: "fedora@sha256:ef01", ScanTypes: []string{"openscap"}}, false},
+	} {
+		inspection, err := q.Submit(test.req)
+		if err != nil {
+			t.Fatalf("%s: unable to submit an inspection: %v", k, err)
+		}
+		if inspection.Reused != test.reused {
+			t.Errorf("%s: expected reused %t but got %t", k, test.reused, inspection.Reused)
+		}
+		if test.reused && (inspection.Status != iiapi.InspectionSucceeded || len(inspection.ResultID) == 0) {
+			t.Errorf("%s: unexpected reused inspection %+v", k, inspection)
+		}
+		waitFor(t, q, inspection.ID, iiapi.InspectionSucceeded)
+	}
+}
+
+func TestInspectionQueueEvicts(t *testing.T) {
+	results := store.NewMemoryStore()
+	q, cleanup := newTestQueueWithStore(t, results, func(opts iicmd.ImageInspectorOptions) (*iiapi.InspectorReport, error) {
+		meta := &iiapi.InspectorMetadata{}
+		meta.ID = strings.Replace(opts.Image, "fedora:", "sha256:00", 1)
+		meta.RepoDigests = []string{strings.Replace(opts.Image, ":", "@sha256:", 1)}
+		return &iiapi.InspectorReport{Image: opts.Image, Metadata: meta}, nil
+	})
+	defer cleanup()
+	q.opts.InspectionRetention = 1
+	q.Start()
+
+	first, err := q.Submit(iiapi.InspectionRequest{Image: "fedora:22", ScanTypes: []string{"openscap"}})
+	if err != nil {
+		t.Fatalf("Unable to submit an inspection: %v", err)
+	}
+	first = waitFor(t, q, first.ID, iiapi.InspectionSucceeded)
+	second, err := q.Submit(iiapi.InspectionRequest{Image: "fedora:23", ScanTypes: []string{"openscap"}})
+	if err != nil {
+		t.Fatalf("Unable to submit an inspection: %v", err)
+	}
+	waitFor(t, q, second.ID, iiapi.InspectionSucceeded)
+
+	if _, _, _, ok := q.Get(first.ID); ok {
+		t.Errorf("The oldest inspection should have been evicted")
+	}
+	if _, err := os.Stat(path.Join(q.workDir, first.ID)); !os.IsNotExist(err) {
+		t.Errorf("The directory of the evicted inspection should have been removed: %v", err)
+	}
+	if result, err := results.Latest("fedora@sha256:22"); err != nil || result.ID != first.ResultID {
+		t.Errorf("The result of the evicted inspection should have been kept: %v", err)
+	}
+	if _, err := os.Stat(path.Join(q.workDir, second.ID)); err != nil {
+		t.Errorf("The directory of the latest inspection should have been kept: %v", err)
+	}
+
+	q.opts.InspectionTTL = time.Nanosecond
+	if _, err := q.Submit(iiapi.InspectionRequest{Image: "fedora:24", ScanTypes: []string{"openscap"}}); err != nil {
+		t.Fatalf("Unable to submit an inspection: %v", err)
+	}
+	if _, _, _, ok := q.Get(second.ID); ok {
+		t.Errorf("The expired inspection should have been evicted")
+	}
+}
+
+func TestInspectionQueueServiceOptions(t *testing.T) {
+	workDir, err := ioutil.TempDir("", "inspections-")
+	if err != nil {
+		t.Fatalf("Unable to create work dir: %v", err)
+	}
+	defer os.RemoveAll(workDir)
+	opts := iicmd.NewDefaultImageInspectorOptions()
+	opts.Daemon = true
+	opts.Serve = "0.0.0.0:8443"
+	opts.TLSCert = "queue.go"
+	opts.TLSKey = "queue.go"
+	opts.TLSClientCA = "queue.go"
+	opts.AuthContentUsers.Values = []string{"user:admin"}
+	opts.IdleTimeout = time.Hour
+	opts.Webhook = true
+	opts.Controller = true
+	opts.Publish = "pod"
+	if err := opts.Validate(); err != nil {
+		t.Fatalf("Invalid service options: %v", err)
+	}
+
+	q := NewInspectionQueue(*opts, workDir, store.NewMemoryStore(), func(opts iicmd.ImageInspectorOptions) (*iiapi.InspectorReport, error) {
+		if len(opts.Serve) > 0 || len(opts.TLSCert) > 0 || opts.Webhook {
+			return nil, fmt.Errorf("unexpected options %+v", opts)
+		}
+		return &iiapi.InspectorReport{Image: opts.Image}, nil
+	})
+	succeeded := make(chan iiapi.Inspection, 1)
+	q.OnSucceeded(func(inspection iiapi.Inspection, report *iiapi.InspectorReport) {
+		succeeded <- inspection
+	})
+	q.Start()
+	inspection, err := q.Submit(iiapi.InspectionRequest{Image: "fedora:22", ScanTypes: []string{"openscap"}})
+	if err != nil {
+		t.Fatalf("Unable to submit an inspection with the options of the service: %v", err)
+	}
+	waitFor(t, q, inspection.ID, iiapi.InspectionSucceeded)
+	select {
+	case done := <-succeeded:
+		if done.ID != inspection.ID || done.Finished == nil {
+			t.Errorf("Unexpected succeeded inspection %+v", done)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("The queue didn't call back with the succeeded inspection")
+	}
+}
@@ -0,0 +1,357 @@
+package daemon
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"path"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/auth"
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+	"github.com/openshift/image-inspector/pkg/controller"
+	apiserver "github.com/openshift/image-inspector/pkg/imageserver"
+	ii "github.com/openshift/image-inspector/pkg/inspector"
+	"github.com/openshift/image-inspector/pkg/kube"
+	"github.com/openshift/image-inspector/pkg/metrics"
+	"github.com/openshift/image-inspector/pkg/output"
+	"github.com/openshift/image-inspector/pkg/publish"
+	"github.com/openshift/image-inspector/pkg/store"
+	"github.com/openshift/image-inspector/pkg/webhook"
+)
+
+const (
+	VERSION_TAG          = "v2"
+	INSPECTIONS_URL_PATH = ii.API_URL_PREFIX + "/" + VERSION_TAG + "/inspections"
+	RESULTS_URL_PATH     = ii.API_URL_PREFIX + "/" + VERSION_TAG + "/results"
+	REPORT_PATH          = "report"
+	CONTENT_PATH         = "content"
+	SEARCH_PATH          = "search"
+	ATTESTATION_PATH     = "attestation"
+	WEBHOOK_URL_PATH     = ii.API_URL_PREFIX + "/" + VERSION_TAG + "/admission/pods"
+	IMAGES_URL_PATH      = ii.API_URL_PREFIX + "/" + VERSION_TAG + "/images"
+	// STORE_DIR is the directory of the results store below the work
+	// directory when no store path is given
+	STORE_DIR = "store"
+	// MAX_REQUEST_SIZE is the maximum size of the body of an inspection request
+	MAX_REQUEST_SIZE = 64 * 1024
+)
+
+// InspectionService is a long-running service inspecting the images
+// submitted through its API.
+type InspectionService struct {
+	opts    iicmd.ImageInspectorOptions
+	queue   *InspectionQueue
+	results store.ResultStore
+	auth    *auth.Filter
+	// webhook is the admission webhook, nil when it isn't served
+	webhook *webhook.Webhook
+	// controller discovers the images of the cluster, nil when it isn't run
+	controller *controller.Controller
+	// publisher publishes the summaries of the inspections, nil when they
+	// aren't published
+	publisher publish.Publisher
+}
+
+// NewInspectionService returns a new inspection service configured by opts.
+func NewInspectionService(opts iicmd.ImageInspectorOptions) *InspectionService {
+	return &InspectionService{opts: opts}
+}
+
+// runInspection runs a single inspection with the default inspector.
+func runInspection(opts iicmd.ImageInspectorOptions) (*iiapi.InspectorReport, error) {
+	inspector := ii.NewDefaultImageInspector(opts)
+	if err := inspector.Inspect(); err != nil {
+		return nil, err
+	}
+	return inspector.Report(), nil
+}
+
+// queueTrigger triggers the inspections of the admission webhook on the
+// queue of the service.
+type queueTrigger struct {
+	queue *InspectionQueue
+}
+
+func (t queueTrigger) Trigger(image string) error {
+	_, err := t.queue.Submit(iiapi.InspectionRequest{Image: image, ScanTypes: []string{webhook.SCAN_TYPE}})
+	return err
+}
+
+// publishSummary publishes the summary of inspection, on the pods running
+// its image when annotating the pods.
+func (s *InspectionService) publishSummary(inspection iiapi.Inspection, report *iiapi.InspectorReport) {
+	resultsURL := ""
+	if len(s.opts.PublishURL) > 0 {
+		resultsURL = strings.TrimSuffix(s.opts.PublishURL, "/") + INSPECTIONS_URL_PATH + "/" + inspection.ID + "/" + REPORT_PATH
+	}
+	var pods []string
+	if s.controller != nil {
+		pods = s.controller.Pods(inspection.Image)
+	}
+	summary := publish.NewScanSummary(report, *inspection.Finished, resultsURL)
+	if err := s.publisher.Publish(summary, pods); err != nil {
+		log.Printf("WARNING: Unable to publish the summary of inspection %s: %v", inspection.ID, err)
+	}
+}
+
+// Serve starts the workers and serves the API until it fails.
+func (s *InspectionService) Serve() error {
+	var err error
+	workDir := s.opts.DstPath
+	if len(workDir) == 0 {
+		tempDir, err := ii.NewTempDir(ii.TEMP_DIR_ROOT, ii.TEMP_DIR_PREFIX+"inspections-")
+		if err != nil {
+			return err
+		}
+		workDir = tempDir.Path
+		defer func() {
+			if s.opts.Keep {
+				log.Printf("Keeping %s", workDir)
+			} else {
+				log.Printf("Removing %s", workDir)
+			}
+			if err := tempDir.Release(s.opts.Keep); err != nil {
+				log.Printf("WARNING: %v", err)
+			}
+		}()
+	}
+	if s.auth, err = ii.NewAuthFilter(s.opts); err != nil {
+		return err
+	}
+	scheme := "http"
+	var tlsConfig *tls.Config
+	if len(s.opts.TLSCert) > 0 {
+		if tlsConfig, err = apiserver.NewTLSConfig(s.opts.TLSCert, s.opts.TLSKey, s.opts.TLSClientCA); err != nil {
+			return err
+		}
+		scheme = "https"
+	}
+
+	storePath := s.opts.StorePath
+	if len(storePath) == 0 {
+		storePath = path.Join(workDir, STORE_DIR)
+	}
+	if s.results, err = store.NewDiskStore(storePath); err != nil {
+		return err
+	}
+	s.queue = NewInspectionQueue(s.opts, workDir, s.results, runInspection)
+	if len(s.opts.Publish) > 0 {
+		client, err := kube.NewInClusterClient(ii.KUBE_API_TIMEOUT)
+		if err != nil {
+			return err
+		}
+		if s.publisher, err = publish.NewPublisher(s.opts.Publish, client, s.opts.PublishNamespace); err != nil {
+			return err
+		}
+		s.queue.OnSucceeded(s.publishSummary)
+	}
+	s.queue.Start()
+	if !s.opts.Keep {
+		defer func() {
+			if err := s.queue.Cleanup(); err != nil {
+				log.Printf("WARNING: %v", err)
+			}
+		}()
+	}
+
+	if s.opts.Webhook {
+		policy := webhook.Policy{DenySeverity: s.opts.WebhookDenySeverity, FailOpen: s.opts.WebhookFailOpen}
+		s.webhook = webhook.NewWebhook(policy, webhook.NewRegistryResolver(s.opts), s.results, queueTrigger{s.queue})
+		log.Printf("Serving the admission webhook for pods on %s://%s%s", scheme, s.opts.Serve, WEBHOOK_URL_PATH)
+	}
+
+	if s.opts.Controller {
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return fmt.Errorf("Unable to configure the Kubernetes client: %v\n", err)
+		}
+		clientset, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return fmt.Errorf("Unable to create the Kubernetes client: %v\n", err)
+		}
+		// the discovered images get the scans the webhook evaluates
+		s.controller = controller.NewController(s.queue, []string{webhook.SCAN_TYPE}, s.opts.ControllerInterval)
+		stop := make(chan struct{})
+		defer close(stop)
+		go s.controller.Run(clientset, stop)
+		log.Printf("Inspecting the images of the pods of the cluster, the discovered images are served on %s://%s%s", scheme, s.opts.Serve, IMAGES_URL_PATH)
+	}
+
+	log.Printf("Serving inspections of %d workers on %s://%s%s", s.opts.Workers, scheme, s.opts.Serve, INSPECTIONS_URL_PATH)
+	s.registerHandlers(http.DefaultServeMux)
+	server := apiserver.NewServer(s.opts.Serve, metrics.InstrumentHandler(http.DefaultServeMux), tlsConfig)
+	server.ServeTimeout = s.opts.ServeTimeout
+	server.IdleTimeout = s.opts.IdleTimeout
+	server.IdleExemptPaths = []string{ii.HEALTHZ_URL_PATH, ii.METRICS_URL_PATH}
+	return server.ListenAndServe()
+}
+
+// registerHandlers registers the API of the service on mux.
+func (s *InspectionService) registerHandlers(mux *http.ServeMux) {
+	mux.HandleFunc(ii.HEALTHZ_URL_PATH, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok\n"))
+	})
+	mux.HandleFunc(ii.API_URL_PREFIX, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, iiapi.APIVersions{Versions: []string{VERSION_TAG}})
+	})
+	mux.Handle(INSPECTIONS_URL_PATH, s.auth.RequireFor(inspectionAccess, http.HandlerFunc(s.handleInspections)))
+	mux.Handle(INSPECTIONS_URL_PATH+"/", s.auth.RequireFor(inspectionAccess, http.HandlerFunc(s.handleInspection)))
+	mux.Handle(RESULTS_URL_PATH+"/", s.auth.RequireFunc(auth.AccessMetadata, s.handleResults))
+	mux.Handle(ii.METRICS_URL_PATH, s.auth.Require(auth.AccessMetadata, metrics.DefaultRegistry))
+	if s.webhook != nil {
+		mux.Handle(WEBHOOK_URL_PATH, s.auth.Require(auth.AccessMetadata, s.webhook))
+	}
+	if s.controller != nil {
+		mux.Handle(IMAGES_URL_PATH, s.auth.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
+			writeJSON(w, http.StatusOK, s.controller.Images())
+		}))
+	}
+}
+
+// inspectionAccess is the access needed by the requests to the inspections:
+// submitting an inspection and reading or searching the content of an image
+// need access to the content.
+func inspectionAccess(r *http.Request) auth.Access {
+	if r.Method == "POST" {
+		return auth.AccessContent
+	}
+	rest := strings.TrimPrefix(r.URL.Path, INSPECTIONS_URL_PATH+"/")
+	if parts := strings.SplitN(rest, "/", 3); len(parts) > 1 && (parts[1] == CONTENT_PATH || parts[1] == SEARCH_PATH) {
+		return auth.AccessContent
+	}
+	return auth.AccessMetadata
+}
+
+// handleInspections lists the inspections and accepts new ones.
+func (s *InspectionService) handleInspections(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		writeJSON(w, http.StatusOK, s.queue.List())
+	case "POST":
+		var req iiapi.InspectionRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, MAX_REQUEST_SIZE)).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("Unable to parse the inspection request: %v", err), http.StatusBadRequest)
+			return
+		}
+		inspection, err := s.queue.Submit(req)
+		if err == ErrQueueFull {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Location", INSPECTIONS_URL_PATH+"/"+inspection.ID)
+		writeJSON(w, http.StatusAccepted, inspection)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleInspection serves the status, the report, the attestation, the
+// content and the search of the content of a single inspection.
+func (s *InspectionService) handleInspection(w http.ResponseWriter, r *http.Request) {
+	rest := strings.TrimPrefix(r.URL.Path, INSPECTIONS_URL_PATH+"/")
+	parts := strings.SplitN(rest, "/", 2)
+	inspection, report, contentPath, ok := s.queue.Get(parts[0])
+	if !ok {
+		http.Error(w, fmt.Sprintf("Inspection %s not found", parts[0]), http.StatusNotFound)
+		return
+	}
+
+	if len(parts) == 1 {
+		writeJSON(w, http.StatusOK, inspection)
+		return
+	}
+	if report == nil {
+		http.Error(w, fmt.Sprintf("Inspection %s is %s", inspection.ID, inspection.Status), http.StatusConflict)
+		return
+	}
+
+	switch {
+	case parts[1] == REPORT_PATH:
+		format := r.URL.Query().Get("output")
+		if len(format) == 0 {
+			format = "json"
+		}
+		if err := output.Render(w, format, report); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	case parts[1] == ATTESTATION_PATH:
+		if report.Attestation == nil {
+			http.Error(w, fmt.Sprintf("Inspection %s was not attested", inspection.ID), http.StatusNotFound)
+			return
+		}
+		writeJSON(w, http.StatusOK, report.Attestation)
+	case parts[1] == CONTENT_PATH || strings.HasPrefix(parts[1], CONTENT_PATH+"/"):
+		if len(contentPath) == 0 {
+			http.Error(w, fmt.Sprintf("The content of inspection %s is not available", inspection.ID), http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case "GET", "HEAD", "OPTIONS", "PROPFIND":
+		default:
+			http.Error(w, "The content of the inspections is read-only", http.StatusMethodNotAllowed)
+			return
+		}
+		handler := apiserver.NewContentHandler(INSPECTIONS_URL_PATH+"/"+inspection.ID+"/"+CONTENT_PATH,
+			apiserver.NewRootedFileSystem(contentPath))
+		handler.ServeHTTP(w, r)
+	case parts[1] == SEARCH_PATH:
+		if len(contentPath) == 0 {
+			http.Error(w, fmt.Sprintf("The content of inspection %s is not available", inspection.ID), http.StatusNotFound)
+			return
+		}
+		apiserver.NewSearchHandler(apiserver.NewRootedFileSystem(contentPath)).ServeHTTP(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// handleResults answers the queries to the results store:
+// latest?image=X is the latest result of the image X, history?image=X all
+// its results and affected?cve=Y the latest result of each image affected
+// by the vulnerability Y.
+func (s *InspectionService) handleResults(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var content interface{}
+	var err error
+	switch strings.TrimPrefix(r.URL.Path, RESULTS_URL_PATH+"/") {
+	case "latest":
+		content, err = s.results.Latest(query.Get("image"))
+	case "history":
+		content, err = s.results.History(query.Get("image"))
+	case "affected":
+		content, err = s.results.AffectedBy(query.Get("cve"))
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if err == store.ErrNotFound {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, content)
+}
+
+func writeJSON(w http.ResponseWriter, status int, content interface{}) {
+	body, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body)
+}
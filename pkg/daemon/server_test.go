@@ -0,0 +1,108 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+	"github.com/openshift/image-inspector/pkg/store"
+)
+
+func TestInspectionServiceAPI(t *testing.T) {
+	results := store.NewMemoryStore()
+	q, cleanup := newTestQueueWithStore(t, results, func(opts iicmd.ImageInspectorOptions) (*iiapi.InspectorReport, error) {
+		if err := os.Mkdir(opts.DstPath, 0755); err != nil {
+			return nil, err
+		}
+		if err := ioutil.WriteFile(path.Join(opts.DstPath, "hello"), []byte("world"), 0644); err != nil {
+			return nil, err
+		}
+		meta := &iiapi.InspectorMetadata{}
+		meta.ID = "sha256:1234"
+		return &iiapi.InspectorReport{
+			Image:    opts.Image,
+			Metadata: meta,
+			Findings: []iiapi.Finding{{
+				Scanner:    "OpenSCAP",
+				ID:         "oval:com.redhat.rhsa:def:20160001",
+				Result:     iiapi.ResultFail,
+				References: []string{"CVE-2016-0001"},
+			}},
+			Attestation: &iiapi.Envelope{PayloadType: "application/vnd.in-toto+json"},
+		}, nil
+	})
+	defer cleanup()
+	q.Start()
+
+	service := &InspectionService{queue: q, results: results}
+	mux := http.NewServeMux()
+	service.registerHandlers(mux)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+INSPECTIONS_URL_PATH, "application/json",
+		bytes.NewBufferString(`{"image": "fedora:22"}`))
+	if err != nil {
+		t.Fatalf("Unable to submit an inspection: %v", err)
+	}
+	var inspection iiapi.Inspection
+	json.NewDecoder(resp.Body).Decode(&inspection)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted || len(inspection.ID) == 0 ||
+		resp.Header.Get("Location") != INSPECTIONS_URL_PATH+"/"+inspection.ID {
+		t.Fatalf("Unexpected response %d %+v", resp.StatusCode, inspection)
+	}
+	waitFor(t, q, inspection.ID, iiapi.InspectionSucceeded)
+
+	for url, expected := range map[string]struct {
+		status int
+		body   string
+	}{
+		INSPECTIONS_URL_PATH:                                          {http.StatusOK, inspection.ID},
+		INSPECTIONS_URL_PATH + "/" + inspection.ID:                    {http.StatusOK, `"status": "Succeeded"`},
+		INSPECTIONS_URL_PATH + "/" + inspection.ID + "/report":        {http.StatusOK, `"Image": "fedora:22"`},
+		INSPECTIONS_URL_PATH + "/" + inspection.ID + "/attestation":   {http.StatusOK, `"payloadType": "application/vnd.in-toto+json"`},
+		INSPECTIONS_URL_PATH + "/" + inspection.ID + "/content/hello": {http.StatusOK, "world"},
+		INSPECTIONS_URL_PATH + "/" + inspection.ID + "/nosuchthing":   {http.StatusNotFound, ""},
+		INSPECTIONS_URL_PATH + "/nosuchid":                            {http.StatusNotFound, ""},
+	} {
+		resp, err := http.Get(server.URL + url)
+		if err != nil {
+			t.Errorf("Unable to get %s: %v", url, err)
+			continue
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != expected.status || !strings.Contains(string(body), expected.body) {
+			t.Errorf("%s expected %d containing %q but got %d: %s", url, expected.status, expected.body, resp.StatusCode, body)
+		}
+	}
+
+	req, _ := http.NewRequest("PUT", server.URL+INSPECTIONS_URL_PATH+"/"+inspection.ID+"/content/new",
+		bytes.NewBufferString("content"))
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Unable to put content: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("Writing the content should not be allowed, got %d", resp.StatusCode)
+	}
+
+	resp, err = http.Post(server.URL+INSPECTIONS_URL_PATH, "application/json", bytes.NewBufferString(`{`))
+	if err != nil {
+		t.Fatalf("Unable to submit an inspection: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("A malformed request should have been refused, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,306 @@
+package imageserver
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/webdav"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+const (
+	// ARCHIVE_TAR_GZ is the value of the archive parameter requesting a
+	// gzipped tarball of a subtree
+	ARCHIVE_TAR_GZ = "tar.gz"
+)
+
+// linkReader is implemented by the file systems able to read the target of
+// a symlink without following it.
+type linkReader interface {
+	Readlink(name string) (string, error)
+}
+
+// localDir is a webdav.Dir also reading symlinks.
+type localDir struct {
+	webdav.Dir
+}
+
+// ensures this always implements the interface or fail compilation.
+var _ linkReader = localDir{}
+
+// NewDirFileSystem returns the webdav.FileSystem of dir, resolving the paths
+// like webdav.Dir does. It's only safe to use after a chroot into dir.
+func NewDirFileSystem(dir string) webdav.FileSystem {
+	return localDir{webdav.Dir(dir)}
+}
+
+func (d localDir) Readlink(name string) (string, error) {
+	return os.Readlink(filepath.Join(string(d.Dir), filepath.FromSlash(path.Clean("/"+name))))
+}
+
+// FileHandler serves the content of a file system over plain HTTP: the
+// files with their content type, ETag and range support, the directories as
+// JSON listings and, with ?archive=tar.gz, whole subtrees as tarballs.
+type FileHandler struct {
+	// Prefix is the URL path prefix stripped to get the path in FileSystem
+	Prefix     string
+	FileSystem webdav.FileSystem
+}
+
+// contentHandler serves the reads of the content with a FileHandler and
+// the rest with WebDAV.
+type contentHandler struct {
+	files *FileHandler
+	dav   *webdav.Handler
+}
+
+// NewContentHandler returns the handler of the content of fs below prefix.
+// GET and HEAD, which WebDAV clients use to read the files too, are served
+// by a FileHandler and the other methods by WebDAV.
+func NewContentHandler(prefix string, fs webdav.FileSystem) http.Handler {
+	return &contentHandler{
+		files: &FileHandler{Prefix: prefix, FileSystem: fs},
+		dav: &webdav.Handler{
+			Prefix:     prefix,
+			FileSystem: fs,
+			LockSystem: webdav.NewMemLS(),
+		},
+	}
+}
+
+func (h *contentHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "GET" || r.Method == "HEAD" {
+		h.files.ServeHTTP(w, r)
+		return
+	}
+	h.dav.ServeHTTP(w, r)
+}
+
+func (h *FileHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "Only GET and HEAD are allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, h.Prefix)
+	if len(h.Prefix) > 0 && len(name) == len(r.URL.Path) {
+		http.NotFound(w, r)
+		return
+	}
+	name = path.Clean("/" + name)
+
+	fi, err := h.FileSystem.Stat(name)
+	if err != nil {
+		writeFileError(w, err)
+		return
+	}
+	switch archive := r.URL.Query().Get("archive"); {
+	case len(archive) > 0 && archive != ARCHIVE_TAR_GZ:
+		http.Error(w, fmt.Sprintf("%s is not a supported archive, only %s is", archive, ARCHIVE_TAR_GZ), http.StatusBadRequest)
+	case len(archive) > 0:
+		h.serveArchive(w, r, name, fi)
+	case fi.IsDir():
+		h.serveListing(w, name)
+	default:
+		h.serveFile(w, r, name, fi)
+	}
+}
+
+// serveFile serves the content of the file name, http.ServeContent takes
+// care of the content type, the conditional requests and the ranges.
+func (h *FileHandler) serveFile(w http.ResponseWriter, r *http.Request, name string, fi os.FileInfo) {
+	file, err := h.FileSystem.OpenFile(name, os.O_RDONLY, 0)
+	if err != nil {
+		writeFileError(w, err)
+		return
+	}
+	defer file.Close()
+	w.Header().Set("ETag", fmt.Sprintf(`"%x-%x"`, fi.ModTime().UnixNano(), fi.Size()))
+	http.ServeContent(w, r, fi.Name(), fi.ModTime(), file)
+}
+
+// serveListing serves the entries of the directory name as JSON.
+func (h *FileHandler) serveListing(w http.ResponseWriter, name string) {
+	entries, err := readDir(h.FileSystem, name)
+	if err != nil {
+		writeFileError(w, err)
+		return
+	}
+	listing := make([]iiapi.FileInfo, 0, len(entries))
+	for _, fi := range entries {
+		listing = append(listing, h.fileInfo(path.Join(name, fi.Name()), fi))
+	}
+	body, err := json.MarshalIndent(listing, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// serveArchive streams the subtree name as a gzipped tarball. The entries
+// are named relative to the parent of name, symlinks are never followed.
+func (h *FileHandler) serveArchive(w http.ResponseWriter, r *http.Request, name string, fi os.FileInfo) {
+	base := path.Base(name)
+	if name == "/" {
+		base = ""
+	}
+	archiveName := "root.tar.gz"
+	if len(base) > 0 {
+		archiveName = base + ".tar.gz"
+	}
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", archiveName))
+	if r.Method == "HEAD" {
+		return
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	err := h.writeTree(tw, name, base, fi)
+	if err == nil {
+		if err = tw.Close(); err == nil {
+			err = gz.Close()
+		}
+	}
+	if err != nil {
+		// the response is already on its way, all we can do is to stop it
+		log.Printf("Unable to write the archive of %s: %v", name, err)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// writeTree writes the entry name of the file system, and its content if
+// it's a directory, to tw as archiveName.
+func (h *FileHandler) writeTree(tw *tar.Writer, name, archiveName string, fi os.FileInfo) error {
+	if len(archiveName) > 0 {
+		link := ""
+		if fi.Mode()&os.ModeSymlink != 0 {
+			link, _ = h.readlink(name)
+		}
+		hdr, err := tar.FileInfoHeader(fi, link)
+		if err != nil {
+			// sockets can't be archived
+			return nil
+		}
+		hdr.Name = archiveName
+		if fi.IsDir() {
+			hdr.Name += "/"
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if fi.Mode().IsRegular() {
+			file, err := h.FileSystem.OpenFile(name, os.O_RDONLY, 0)
+			if err != nil {
+				return err
+			}
+			_, err = io.CopyN(tw, file, hdr.Size)
+			file.Close()
+			return err
+		}
+	}
+	if !fi.IsDir() {
+		return nil
+	}
+
+	entries, err := readDir(h.FileSystem, name)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := h.writeTree(tw, path.Join(name, entry.Name()), path.Join(archiveName, entry.Name()), entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readDir returns the entries of the directory name of fs sorted by name.
+func readDir(fs webdav.FileSystem, name string) ([]os.FileInfo, error) {
+	dir, err := fs.OpenFile(name, os.O_RDONLY, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer dir.Close()
+	entries, err := dir.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
+
+// readlink returns the target of the symlink name, if the file system can
+// read it.
+func (h *FileHandler) readlink(name string) (string, error) {
+	reader, ok := h.FileSystem.(linkReader)
+	if !ok {
+		return "", fmt.Errorf("Unable to read the symlink %s", name)
+	}
+	return reader.Readlink(name)
+}
+
+// fileInfo returns the description of the entry name.
+func (h *FileHandler) fileInfo(name string, fi os.FileInfo) iiapi.FileInfo {
+	mode := fi.Mode()
+	perm := uint32(mode.Perm())
+	if mode&os.ModeSetuid != 0 {
+		perm |= 04000
+	}
+	if mode&os.ModeSetgid != 0 {
+		perm |= 02000
+	}
+	if mode&os.ModeSticky != 0 {
+		perm |= 01000
+	}
+	info := iiapi.FileInfo{
+		Name: fi.Name(),
+		Size: fi.Size(),
+		Mode: fmt.Sprintf("%04o", perm),
+		Type: fileType(mode),
+	}
+	if info.Type == iiapi.FileTypeSymlink {
+		info.LinkTarget, _ = h.readlink(name)
+	}
+	return info
+}
+
+// fileType returns the type of the files with mode.
+func fileType(mode os.FileMode) iiapi.FileType {
+	switch {
+	case mode.IsRegular():
+		return iiapi.FileTypeRegular
+	case mode.IsDir():
+		return iiapi.FileTypeDirectory
+	case mode&os.ModeSymlink != 0:
+		return iiapi.FileTypeSymlink
+	}
+	return iiapi.FileTypeOther
+}
+
+// writeFileError answers with the status matching err.
+func writeFileError(w http.ResponseWriter, err error) {
+	switch {
+	case os.IsNotExist(err):
+		http.Error(w, "Not found", http.StatusNotFound)
+	case os.IsPermission(err):
+		http.Error(w, "Forbidden", http.StatusForbidden)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,175 @@
+package imageserver
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+func TestFileHandler(t *testing.T) {
+	root, err := ioutil.TempDir("", "files-")
+	if err != nil {
+		t.Fatalf("Unable to create root: %v", err)
+	}
+	defer os.RemoveAll(root)
+	if err := os.MkdirAll(filepath.Join(root, "etc", "ssl"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"etc/hostname":    "image\n",
+		"etc/ssl/ca.pem":  "-----BEGIN CERTIFICATE-----\n",
+		"etc/index.html":  "<html><body>hello</body></html>",
+		"etc/passwd-":     "root:x:0:0::/root:/bin/sh\n",
+		"etc/ssl/openssl": "config",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(root, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink("/etc/passwd-", filepath.Join(root, "etc", "passwd")); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(NewContentHandler("/content/", NewRootedFileSystem(root)))
+	defer server.Close()
+
+	get := func(url string, header http.Header) *http.Response {
+		req, _ := http.NewRequest("GET", server.URL+url, nil)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Unable to get %s: %v", url, err)
+		}
+		return resp
+	}
+
+	// files
+	resp := get("/content/etc/index.html", nil)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || string(body) != "<html><body>hello</body></html>" {
+		t.Errorf("unexpected file response %d %q", resp.StatusCode, body)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/html; charset=utf-8" {
+		t.Errorf("unexpected content type %q", ct)
+	}
+	etag := resp.Header.Get("ETag")
+	if len(etag) == 0 {
+		t.Errorf("the ETag is missing")
+	}
+	resp = get("/content/etc/index.html", http.Header{"If-None-Match": {etag}})
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("a matching ETag should get %d, got %d", http.StatusNotModified, resp.StatusCode)
+	}
+	resp = get("/content/etc/index.html", http.Header{"Range": {"bytes=6-11"}})
+	body, _ = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent || string(body) != "<body>" {
+		t.Errorf("unexpected range response %d %q", resp.StatusCode, body)
+	}
+	// the symlinks are resolved inside the root
+	resp = get("/content/etc/passwd", nil)
+	body, _ = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || string(body) != "root:x:0:0::/root:/bin/sh\n" {
+		t.Errorf("unexpected symlink response %d %q", resp.StatusCode, body)
+	}
+	for _, url := range []string{"/content/nosuchfile", "/content/../../etc/nosuchfile", "/other/etc/hostname"} {
+		resp = get(url, nil)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("%s should not be found, got %d", url, resp.StatusCode)
+		}
+	}
+
+	// listings
+	resp = get("/content/etc", nil)
+	var listing []iiapi.FileInfo
+	if err := json.NewDecoder(resp.Body).Decode(&listing); err != nil {
+		t.Fatalf("Unable to decode the listing: %v", err)
+	}
+	resp.Body.Close()
+	expected := []iiapi.FileInfo{
+		{Name: "hostname", Size: 6, Mode: "0644", Type: iiapi.FileTypeRegular},
+		{Name: "index.html", Size: 31, Mode: "0644", Type: iiapi.FileTypeRegular},
+		{Name: "passwd", Mode: "0777", Type: iiapi.FileTypeSymlink, LinkTarget: "/etc/passwd-"},
+		{Name: "passwd-", Size: 26, Mode: "0644", Type: iiapi.FileTypeRegular},
+		{Name: "ssl", Mode: "0755", Type: iiapi.FileTypeDirectory},
+	}
+	for idx := range listing {
+		if listing[idx].Type != iiapi.FileTypeRegular {
+			listing[idx].Size = 0
+		}
+	}
+	if !reflect.DeepEqual(listing, expected) {
+		t.Errorf("expected listing %v, got %v", expected, listing)
+	}
+
+	// archives
+	resp = get("/content/etc?archive=tar.gz", nil)
+	if resp.Header.Get("Content-Disposition") != `attachment; filename="etc.tar.gz"` {
+		t.Errorf("unexpected disposition %q", resp.Header.Get("Content-Disposition"))
+	}
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		t.Fatalf("the archive is not gzipped: %v", err)
+	}
+	tr := tar.NewReader(gz)
+	entries := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("Unable to read the archive: %v", err)
+		}
+		content, _ := ioutil.ReadAll(tr)
+		if hdr.Typeflag == tar.TypeSymlink {
+			content = []byte("-> " + hdr.Linkname)
+		}
+		entries[hdr.Name] = string(content)
+	}
+	resp.Body.Close()
+	expectedEntries := map[string]string{
+		"etc/":            "",
+		"etc/hostname":    "image\n",
+		"etc/index.html":  "<html><body>hello</body></html>",
+		"etc/passwd":      "-> /etc/passwd-",
+		"etc/passwd-":     "root:x:0:0::/root:/bin/sh\n",
+		"etc/ssl/":        "",
+		"etc/ssl/ca.pem":  "-----BEGIN CERTIFICATE-----\n",
+		"etc/ssl/openssl": "config",
+	}
+	if !reflect.DeepEqual(entries, expectedEntries) {
+		t.Errorf("expected archive %v, got %v", expectedEntries, entries)
+	}
+	resp = get("/content/etc?archive=zip", nil)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("an unknown archive should get %d, got %d", http.StatusBadRequest, resp.StatusCode)
+	}
+
+	// the other methods are still WebDAV, and read-only
+	req, _ := http.NewRequest("PROPFIND", server.URL+"/content/etc/hostname", nil)
+	req.Header.Set("Depth", "0")
+	if resp, err = http.DefaultClient.Do(req); err != nil {
+		t.Fatalf("Unable to propfind: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMultiStatus {
+		t.Errorf("PROPFIND should get %d, got %d", http.StatusMultiStatus, resp.StatusCode)
+	}
+}
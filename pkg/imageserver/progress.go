@@ -0,0 +1,73 @@
+package imageserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/openshift/image-inspector/pkg/progress"
+)
+
+const (
+	// EVENTS_MIN_INTERVAL is the minimum interval between two progress
+	// events, the changes in between are sent together
+	EVENTS_MIN_INTERVAL = 250 * time.Millisecond
+)
+
+// NewStatusHandler returns the handler serving the progress tracked by
+// tracker as JSON.
+func NewStatusHandler(tracker *progress.Tracker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := json.MarshalIndent(tracker.Get(), "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	})
+}
+
+// NewEventsHandler returns the handler streaming the progress tracked by
+// tracker as server-sent "progress" events, the first one is the current
+// progress. The stream ends once the inspection is ready or failed.
+func NewEventsHandler(tracker *progress.Tracker) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+		changes, cancel := tracker.Subscribe()
+		defer cancel()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		for {
+			current := tracker.Get()
+			body, err := json.Marshal(current)
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "event: progress\ndata: %s\n\n", body); err != nil {
+				return
+			}
+			flusher.Flush()
+			if current.Done() {
+				return
+			}
+
+			select {
+			case <-changes:
+			case <-r.Context().Done():
+				return
+			}
+			select {
+			case <-time.After(EVENTS_MIN_INTERVAL):
+			case <-r.Context().Done():
+				return
+			}
+		}
+	})
+}
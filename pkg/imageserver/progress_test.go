@@ -0,0 +1,77 @@
+package imageserver
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/progress"
+)
+
+func TestProgressHandlers(t *testing.T) {
+	tracker := progress.NewTracker("fedora:22")
+	tracker.SetLayerBytes("abc", 10, 100)
+	mux := http.NewServeMux()
+	mux.Handle("/status", NewStatusHandler(tracker))
+	mux.Handle("/status/events", NewEventsHandler(tracker))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/status")
+	if err != nil {
+		t.Fatalf("Unable to get the status: %v", err)
+	}
+	var status iiapi.InspectionProgress
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		t.Fatalf("Unable to decode the status: %v", err)
+	}
+	resp.Body.Close()
+	if status.Stage != iiapi.ProgressPulling || status.Layers["abc"].Downloaded != 10 {
+		t.Errorf("unexpected status %#v", status)
+	}
+
+	resp, err = http.Get(server.URL + "/status/events")
+	if err != nil {
+		t.Fatalf("Unable to get the events: %v", err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("unexpected content type %q", ct)
+	}
+	events := bufio.NewScanner(resp.Body)
+	next := func() iiapi.InspectionProgress {
+		var event iiapi.InspectionProgress
+		for events.Scan() {
+			line := events.Text()
+			if strings.HasPrefix(line, "data: ") {
+				if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event); err != nil {
+					t.Fatalf("Unable to decode the event %q: %v", line, err)
+				}
+				return event
+			}
+		}
+		t.Fatalf("the stream ended: %v", events.Err())
+		return event
+	}
+
+	if event := next(); event.Stage != iiapi.ProgressPulling {
+		t.Errorf("the first event should be the current progress, got %#v", event)
+	}
+	tracker.SetStage(iiapi.ProgressExtracting)
+	if event := next(); event.Stage != iiapi.ProgressExtracting {
+		t.Errorf("expected an extracting event, got %#v", event)
+	}
+	tracker.FileExtracted()
+	tracker.SetStage(iiapi.ProgressReady)
+	for event := next(); event.Stage != iiapi.ProgressReady; event = next() {
+	}
+	for events.Scan() {
+		if len(events.Text()) > 0 {
+			t.Errorf("the stream should end once the inspection is ready, got %q", events.Text())
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package imageserver
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/net/webdav"
+)
+
+const (
+	// MAX_SYMLINKS is the maximum number of symlinks followed resolving a path.
+	MAX_SYMLINKS = 255
+)
+
+// ResolveInRoot resolves name inside root as if root was the root directory
+// of the system: ".." and absolute symlinks never lead outside of root. The
+// returned path is a path on the host, below root.
+func ResolveInRoot(root, name string) (string, error) {
+	resolved := "/"
+	unresolved := name
+	followed := 0
+	for len(unresolved) > 0 {
+		var component string
+		if idx := strings.Index(unresolved, "/"); idx >= 0 {
+			component, unresolved = unresolved[:idx], unresolved[idx+1:]
+		} else {
+			component, unresolved = unresolved, ""
+		}
+		if component == "" || component == "." {
+			continue
+		}
+
+		next := path.Join(resolved, component)
+		fi, err := os.Lstat(filepath.Join(root, filepath.FromSlash(next)))
+		if err != nil || fi.Mode()&os.ModeSymlink == 0 {
+			// missing paths and regular components are taken as they are
+			resolved = next
+			continue
+		}
+
+		followed++
+		if followed > MAX_SYMLINKS {
+			return "", fmt.Errorf("Too many symlinks resolving %s", name)
+		}
+		target, err := os.Readlink(filepath.Join(root, filepath.FromSlash(next)))
+		if err != nil {
+			return "", err
+		}
+		if path.IsAbs(target) {
+			resolved = "/"
+		}
+		unresolved = target + "/" + unresolved
+	}
+	return filepath.Join(root, filepath.FromSlash(resolved)), nil
+}
+
+// rootedDir is a read-only webdav.FileSystem serving the content of a
+// directory with all the paths resolved by ResolveInRoot. It's safe to use
+// without a chroot.
+type rootedDir string
+
+// ensures this always implements the interface or fail compilation.
+var _ webdav.FileSystem = rootedDir("")
+var _ linkReader = rootedDir("")
+
+// NewRootedFileSystem returns a read-only webdav.FileSystem serving root
+// without ever leaving it.
+func NewRootedFileSystem(root string) webdav.FileSystem {
+	return rootedDir(root)
+}
+
+func (d rootedDir) Mkdir(name string, perm os.FileMode) error {
+	return os.ErrPermission
+}
+
+func (d rootedDir) OpenFile(name string, flag int, perm os.FileMode) (webdav.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC|os.O_APPEND) != 0 {
+		return nil, os.ErrPermission
+	}
+	resolved, err := ResolveInRoot(string(d), name)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(resolved)
+	if err != nil {
+		return nil, err
+	}
+	return readOnlyFile{file}, nil
+}
+
+func (d rootedDir) RemoveAll(name string) error {
+	return os.ErrPermission
+}
+
+func (d rootedDir) Rename(oldName, newName string) error {
+	return os.ErrPermission
+}
+
+func (d rootedDir) Stat(name string) (os.FileInfo, error) {
+	resolved, err := ResolveInRoot(string(d), name)
+	if err != nil {
+		return nil, err
+	}
+	return os.Stat(resolved)
+}
+
+// Readlink returns the target of the symlink name, the parent directories
+// of name are resolved inside the root.
+func (d rootedDir) Readlink(name string) (string, error) {
+	clean := path.Clean("/" + name)
+	parent, err := ResolveInRoot(string(d), path.Dir(clean))
+	if err != nil {
+		return "", err
+	}
+	return os.Readlink(filepath.Join(parent, path.Base(clean)))
+}
+
+// readOnlyFile is a webdav.File refusing writes.
+type readOnlyFile struct {
+	*os.File
+}
+
+func (f readOnlyFile) Write(p []byte) (int, error) {
+	return 0, os.ErrPermission
+}
@@ -0,0 +1,76 @@
+package imageserver
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveInRoot(t *testing.T) {
+	root, err := ioutil.TempDir("", "rootfs-")
+	if err != nil {
+		t.Fatalf("Unable to create root: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	for _, dir := range []string{"etc", "usr/lib"} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatalf("Unable to create %s: %v", dir, err)
+		}
+	}
+	for link, target := range map[string]string{
+		"lib":        "/usr/lib",
+		"etc/shadow": "../../../../etc/shadow-",
+		"etc/passwd": "/etc/passwd-",
+		"etc/loop":   "loop",
+		"relative":   "usr/lib",
+	} {
+		if err := os.Symlink(target, filepath.Join(root, link)); err != nil {
+			t.Fatalf("Unable to create symlink %s: %v", link, err)
+		}
+	}
+
+	for name, expected := range map[string]string{
+		"/":                  "/",
+		"/etc":               "/etc",
+		"/lib/libc.so":       "/usr/lib/libc.so",
+		"/relative":          "/usr/lib",
+		"/../../etc":         "/etc",
+		"/etc/shadow":        "/etc/shadow-",
+		"/etc/passwd":        "/etc/passwd-",
+		"/lib/../../../root": "/root",
+	} {
+		resolved, err := ResolveInRoot(root, name)
+		if err != nil {
+			t.Errorf("%s failed to resolve: %v", name, err)
+			continue
+		}
+		if resolved != filepath.Join(root, expected) {
+			t.Errorf("%s expected to resolve to %s but got %s", name, filepath.Join(root, expected), resolved)
+		}
+	}
+
+	if _, err := ResolveInRoot(root, "/etc/loop"); err == nil {
+		t.Errorf("a symlink loop should have failed")
+	}
+}
+
+func TestRootedFileSystemReadOnly(t *testing.T) {
+	fs := NewRootedFileSystem(".")
+	if _, err := fs.OpenFile("/rootfs.go", os.O_RDONLY, 0); err != nil {
+		t.Errorf("reading a file should have succeeded: %v", err)
+	}
+	if _, err := fs.OpenFile("/rootfs.go", os.O_RDWR, 0); err != os.ErrPermission {
+		t.Errorf("opening a file for writing should have failed")
+	}
+	if err := fs.Mkdir("/newdir", 0755); err != os.ErrPermission {
+		t.Errorf("creating a directory should have failed")
+	}
+	if err := fs.RemoveAll("/rootfs.go"); err != os.ErrPermission {
+		t.Errorf("removing a file should have failed")
+	}
+	if err := fs.Rename("/rootfs.go", "/other.go"); err != os.ErrPermission {
+		t.Errorf("renaming a file should have failed")
+	}
+}
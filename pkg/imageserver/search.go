@@ -0,0 +1,278 @@
+package imageserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/webdav"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+const (
+	// SEARCH_DEFAULT_LIMIT is the number of matches of a page by default
+	SEARCH_DEFAULT_LIMIT = 100
+	// SEARCH_MAX_LIMIT is the maximum number of matches of a page
+	SEARCH_MAX_LIMIT = 1000
+	// SEARCH_MAX_FILES is the number of entries walked before giving up
+	SEARCH_MAX_FILES = 100000
+	// SEARCH_TIMEOUT is how long a search can take before giving up
+	SEARCH_TIMEOUT = 30 * time.Second
+	// SEARCH_MAX_FILE_BYTES is how many bytes of each file the content
+	// search reads
+	SEARCH_MAX_FILE_BYTES = 1 << 20
+	// SEARCH_MAX_LINES is the number of matching lines reported for a file
+	SEARCH_MAX_LINES = 10
+	// SEARCH_MAX_LINE_LENGTH is the length of the matching lines reported
+	SEARCH_MAX_LINE_LENGTH = 256
+)
+
+// errSearchStopped stops the walk of a search.
+var errSearchStopped = fmt.Errorf("Search stopped")
+
+// SearchQuery is a search of the paths and of the content of the image.
+type SearchQuery struct {
+	// Root is the directory searched
+	Root string
+	// Glob matches the names of the entries, or their paths when it has a /
+	Glob string
+	// Regex matches the paths of the entries
+	Regex *regexp.Regexp
+	// Content matches the content of the regular files
+	Content *regexp.Regexp
+	// Offset is the number of matches skipped and Limit the size of the page
+	Offset int
+	Limit  int
+}
+
+// ParseSearchQuery returns the search described by the query parameters
+// path, glob, regex, content, offset and limit.
+func ParseSearchQuery(values url.Values) (*SearchQuery, error) {
+	q := &SearchQuery{
+		Root:  path.Clean("/" + values.Get("path")),
+		Glob:  values.Get("glob"),
+		Limit: SEARCH_DEFAULT_LIMIT,
+	}
+	var err error
+	if len(q.Glob) > 0 {
+		if _, err = path.Match(q.Glob, ""); err != nil {
+			return nil, fmt.Errorf("Invalid glob %s: %v", q.Glob, err)
+		}
+	}
+	if regex := values.Get("regex"); len(regex) > 0 {
+		if q.Regex, err = regexp.Compile(regex); err != nil {
+			return nil, fmt.Errorf("Invalid regex: %v", err)
+		}
+	}
+	if content := values.Get("content"); len(content) > 0 {
+		if q.Content, err = regexp.Compile(content); err != nil {
+			return nil, fmt.Errorf("Invalid content regex: %v", err)
+		}
+	}
+	if len(q.Glob) == 0 && q.Regex == nil && q.Content == nil {
+		return nil, fmt.Errorf("At least one of glob, regex and content is required")
+	}
+	if len(q.Glob) > 0 && q.Regex != nil {
+		return nil, fmt.Errorf("Only one of glob and regex can be used")
+	}
+	if offset := values.Get("offset"); len(offset) > 0 {
+		if q.Offset, err = strconv.Atoi(offset); err != nil || q.Offset < 0 {
+			return nil, fmt.Errorf("Invalid offset %s", offset)
+		}
+	}
+	if limit := values.Get("limit"); len(limit) > 0 {
+		if q.Limit, err = strconv.Atoi(limit); err != nil || q.Limit <= 0 || q.Limit > SEARCH_MAX_LIMIT {
+			return nil, fmt.Errorf("The limit must be between 1 and %d", SEARCH_MAX_LIMIT)
+		}
+	}
+	return q, nil
+}
+
+// SearchHandler searches the content of a file system. The walk never
+// follows symlinks and gives up after MaxFiles entries or Timeout, the
+// content search reads at most MaxFileBytes of each file.
+type SearchHandler struct {
+	FileSystem   webdav.FileSystem
+	MaxFiles     int
+	Timeout      time.Duration
+	MaxFileBytes int64
+}
+
+// NewSearchHandler returns a handler searching fs with the default limits.
+func NewSearchHandler(fs webdav.FileSystem) *SearchHandler {
+	return &SearchHandler{
+		FileSystem:   fs,
+		MaxFiles:     SEARCH_MAX_FILES,
+		Timeout:      SEARCH_TIMEOUT,
+		MaxFileBytes: SEARCH_MAX_FILE_BYTES,
+	}
+}
+
+func (h *SearchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, "Only GET is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	q, err := ParseSearchQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	result, err := h.Search(r.Context(), q)
+	if err != nil {
+		writeFileError(w, err)
+		return
+	}
+	body, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// Search returns the page of the matches of q. Hitting a limit isn't an
+// error, the result tells it's incomplete.
+func (h *SearchHandler) Search(ctx context.Context, q *SearchQuery) (*iiapi.SearchResult, error) {
+	fi, err := h.FileSystem.Stat(q.Root)
+	if err != nil {
+		return nil, err
+	}
+	s := &search{
+		handler:  h,
+		query:    q,
+		ctx:      ctx,
+		deadline: time.Now().Add(h.Timeout),
+		result:   &iiapi.SearchResult{Matches: []iiapi.SearchMatch{}},
+	}
+	if err = s.walk(q.Root, fi); err != nil && err != errSearchStopped {
+		return nil, err
+	}
+	return s.result, nil
+}
+
+// search is the state of a running search.
+type search struct {
+	handler  *SearchHandler
+	query    *SearchQuery
+	ctx      context.Context
+	deadline time.Time
+	// skipped is the number of matches skipped before the page
+	skipped int
+	result  *iiapi.SearchResult
+}
+
+// walk searches the entry name and, if it's a directory, its content.
+func (s *search) walk(name string, fi os.FileInfo) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	if s.result.FilesScanned >= s.handler.MaxFiles {
+		s.result.Incomplete = fmt.Sprintf("walked the maximum of %d entries", s.handler.MaxFiles)
+		return errSearchStopped
+	}
+	if time.Now().After(s.deadline) {
+		s.result.Incomplete = fmt.Sprintf("searched for the maximum of %v", s.handler.Timeout)
+		return errSearchStopped
+	}
+	s.result.FilesScanned++
+
+	if match := s.match(name, fi); match != nil {
+		switch {
+		case s.skipped < s.query.Offset:
+			s.skipped++
+		case len(s.result.Matches) == s.query.Limit:
+			s.result.Next = s.query.Offset + s.query.Limit
+			return errSearchStopped
+		default:
+			s.result.Matches = append(s.result.Matches, *match)
+		}
+	}
+
+	if !fi.IsDir() {
+		return nil
+	}
+	entries, err := readDir(s.handler.FileSystem, name)
+	if err != nil {
+		// the unreadable directories are skipped
+		return nil
+	}
+	for _, entry := range entries {
+		if err := s.walk(path.Join(name, entry.Name()), entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// match returns the match of the entry name, nil if it doesn't match.
+func (s *search) match(name string, fi os.FileInfo) *iiapi.SearchMatch {
+	q := s.query
+	if len(q.Glob) > 0 {
+		subject := path.Base(name)
+		if strings.Contains(q.Glob, "/") {
+			subject = name
+		}
+		if ok, _ := path.Match(q.Glob, subject); !ok {
+			return nil
+		}
+	}
+	if q.Regex != nil && !q.Regex.MatchString(name) {
+		return nil
+	}
+
+	match := &iiapi.SearchMatch{Path: name, Size: fi.Size(), Type: fileType(fi.Mode())}
+	if q.Content == nil {
+		return match
+	}
+	if !fi.Mode().IsRegular() {
+		return nil
+	}
+	file, err := s.handler.FileSystem.OpenFile(name, os.O_RDONLY, 0)
+	if err != nil {
+		return nil
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(file, s.handler.MaxFileBytes))
+	file.Close()
+	if err != nil {
+		return nil
+	}
+	if bytes.IndexByte(content, 0) >= 0 {
+		match.Binary = q.Content.Match(content)
+		if !match.Binary {
+			return nil
+		}
+		return match
+	}
+	for idx, line := range bytes.Split(content, []byte("\n")) {
+		if !q.Content.Match(line) {
+			continue
+		}
+		text := string(line)
+		if len(text) > SEARCH_MAX_LINE_LENGTH {
+			text = text[:SEARCH_MAX_LINE_LENGTH]
+		}
+		match.Lines = append(match.Lines, iiapi.SearchLine{Number: idx + 1, Text: text})
+		if len(match.Lines) == SEARCH_MAX_LINES {
+			break
+		}
+	}
+	if len(match.Lines) == 0 {
+		return nil
+	}
+	return match
+}
@@ -0,0 +1,227 @@
+package imageserver
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// SHUTDOWN_GRACE_PERIOD is how long the requests in progress are waited
+// for when shutting down.
+const SHUTDOWN_GRACE_PERIOD = 20 * time.Second
+
+// Server serves a handler until it receives SIGINT or SIGTERM, a timeout
+// expires or it's stopped, shutting down gracefully. The timeouts only run
+// once armed.
+type Server struct {
+	Addr      string
+	Handler   http.Handler
+	TLSConfig *tls.Config
+	// ServeTimeout stops the server after serving for this long, 0 never does
+	ServeTimeout time.Duration
+	// IdleTimeout stops the server when it receives no request for this long,
+	// 0 never does
+	IdleTimeout time.Duration
+	// IdleExemptPaths are the paths whose requests are not activity for the
+	// idle timeout, like the health checks
+	IdleExemptPaths []string
+
+	stop     chan string
+	stopOnce sync.Once
+	// arm is closed when the timeouts are armed
+	arm     chan struct{}
+	armOnce sync.Once
+	// done receives the outcome of serving
+	done chan error
+
+	lock         sync.Mutex
+	lastActivity time.Time
+	// inFlight is the number of requests being served that are activity
+	inFlight int
+}
+
+// NewServer returns a server of handler on addr, with TLS when tlsConfig is
+// not nil.
+func NewServer(addr string, handler http.Handler, tlsConfig *tls.Config) *Server {
+	return &Server{
+		Addr:      addr,
+		Handler:   handler,
+		TLSConfig: tlsConfig,
+		stop:      make(chan string, 1),
+		arm:       make(chan struct{}),
+	}
+}
+
+// ArmTimeouts starts the serve and the idle timeouts, the server being idle
+// from now on.
+func (s *Server) ArmTimeouts() {
+	s.armOnce.Do(func() {
+		s.touch()
+		close(s.arm)
+	})
+}
+
+// Stop makes ListenAndServe shut down the server, reason is logged.
+func (s *Server) Stop(reason string) {
+	s.stopOnce.Do(func() {
+		s.stop <- reason
+	})
+}
+
+// ListenAndServe serves, with the timeouts armed, until the server is
+// stopped, returning nil once it was shut down gracefully.
+func (s *Server) ListenAndServe() error {
+	if err := s.Start(); err != nil {
+		return err
+	}
+	s.ArmTimeouts()
+	return s.Wait()
+}
+
+// Start listens on Addr and serves in the background until the server is
+// stopped. The timeouts don't run until ArmTimeouts is called.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return fmt.Errorf("Unable to listen on %s: %v\n", s.Addr, err)
+	}
+	s.done = make(chan error, 1)
+	go func() {
+		s.done <- s.serve(listener)
+	}()
+	return nil
+}
+
+// Wait waits for the server started by Start to stop, returning nil once it
+// was shut down gracefully.
+func (s *Server) Wait() error {
+	return <-s.done
+}
+
+// serve serves on listener until the server is stopped.
+func (s *Server) serve(listener net.Listener) error {
+	s.touch()
+	exempt := map[string]bool{}
+	for _, p := range s.IdleExemptPaths {
+		exempt[p] = true
+	}
+	server := &http.Server{
+		Addr: s.Addr,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if exempt[r.URL.Path] {
+				s.Handler.ServeHTTP(w, r)
+				return
+			}
+			s.begin()
+			defer s.end()
+			s.Handler.ServeHTTP(w, r)
+		}),
+		TLSConfig: s.TLSConfig,
+	}
+
+	served := make(chan error, 1)
+	go func() {
+		if s.TLSConfig == nil {
+			served <- server.Serve(listener)
+		} else {
+			served <- server.ServeTLS(listener, "", "")
+		}
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	arm := s.arm
+	var serveTimeout <-chan time.Time
+	var idleCheck <-chan time.Time
+
+	var reason string
+	for len(reason) == 0 {
+		select {
+		case <-arm:
+			arm = nil
+			if s.ServeTimeout > 0 {
+				timer := time.NewTimer(s.ServeTimeout)
+				defer timer.Stop()
+				serveTimeout = timer.C
+			}
+			if s.IdleTimeout > 0 {
+				ticker := time.NewTicker(idleCheckInterval(s.IdleTimeout))
+				defer ticker.Stop()
+				idleCheck = ticker.C
+			}
+		case err := <-served:
+			return err
+		case sig := <-signals:
+			reason = "received " + sig.String()
+		case <-serveTimeout:
+			reason = "served for " + s.ServeTimeout.String()
+		case <-idleCheck:
+			if s.idle() >= s.IdleTimeout {
+				reason = "idle for " + s.IdleTimeout.String()
+			}
+		case reason = <-s.stop:
+		}
+	}
+
+	log.Printf("Shutting down the server: %s", reason)
+	ctx, cancel := context.WithTimeout(context.Background(), SHUTDOWN_GRACE_PERIOD)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		return err
+	}
+	if err := <-served; err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) touch() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.lastActivity = time.Now()
+}
+
+func (s *Server) begin() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.inFlight++
+}
+
+func (s *Server) end() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.inFlight--
+	s.lastActivity = time.Now()
+}
+
+// idle returns how long the server has been idle, a long download is not.
+func (s *Server) idle() time.Duration {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.inFlight > 0 {
+		return 0
+	}
+	return time.Since(s.lastActivity)
+}
+
+// idleCheckInterval is how often the server checks whether it's idle.
+func idleCheckInterval(idleTimeout time.Duration) time.Duration {
+	interval := idleTimeout / 10
+	switch {
+	case interval > time.Second:
+		return time.Second
+	case interval < time.Millisecond:
+		return time.Millisecond
+	}
+	return interval
+}
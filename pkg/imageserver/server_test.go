@@ -0,0 +1,80 @@
+package imageserver
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestServerStops(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	tests := map[string]func(*Server){
+		"stop":          func(s *Server) { go s.Stop("stopped by the test") },
+		"serve timeout": func(s *Server) { s.ServeTimeout = 10 * time.Millisecond },
+		"idle timeout":  func(s *Server) { s.IdleTimeout = 10 * time.Millisecond },
+	}
+	for k, configure := range tests {
+		server := NewServer("127.0.0.1:0", handler, nil)
+		configure(server)
+		done := make(chan error, 1)
+		go func() { done <- server.ListenAndServe() }()
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", k, err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s: the server didn't stop", k)
+		}
+	}
+}
+
+func TestServerIdle(t *testing.T) {
+	server := NewServer("127.0.0.1:0", nil, nil)
+	server.touch()
+	server.begin()
+	time.Sleep(5 * time.Millisecond)
+	if idle := server.idle(); idle != 0 {
+		t.Errorf("a server serving a request should not be idle, got %v", idle)
+	}
+	server.end()
+	time.Sleep(5 * time.Millisecond)
+	if idle := server.idle(); idle < 5*time.Millisecond {
+		t.Errorf("the server should be idle since the last request, got %v", idle)
+	}
+
+	for timeout, expected := range map[time.Duration]time.Duration{
+		time.Hour:        time.Second,
+		time.Second:      100 * time.Millisecond,
+		time.Microsecond: time.Millisecond,
+	} {
+		if interval := idleCheckInterval(timeout); interval != expected {
+			t.Errorf("idle check interval of %v should be %v, got %v", timeout, expected, interval)
+		}
+	}
+}
+
+func TestServerArmTimeouts(t *testing.T) {
+	server := NewServer("127.0.0.1:0", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), nil)
+	server.ServeTimeout = 10 * time.Millisecond
+	server.IdleTimeout = 10 * time.Millisecond
+	if err := server.Start(); err != nil {
+		t.Fatalf("Unable to start the server: %v", err)
+	}
+	done := make(chan error, 1)
+	go func() { done <- server.Wait() }()
+	select {
+	case <-done:
+		t.Fatalf("the timeouts should not run before being armed")
+	case <-time.After(100 * time.Millisecond):
+	}
+	server.ArmTimeouts()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the server didn't stop once the timeouts were armed")
+	}
+}
@@ -0,0 +1,167 @@
+package imageserver
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// tlsReloadInterval is how often the certificates are read again from disk.
+var tlsReloadInterval = 30 * time.Second
+
+// certReloader serves a certificate and verifies the client certificates
+// with CAs that are reloaded from disk when they change.
+type certReloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+	// dirs are the directories of the files, opened when the reloader is
+	// created so that the files can still be read after a chroot
+	dirs map[string]*os.File
+
+	lock      sync.Mutex
+	checked   time.Time
+	certPEM   []byte
+	keyPEM    []byte
+	caPEM     []byte
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	base      *tls.Config
+}
+
+// NewTLSConfig returns a TLS configuration serving the certificate and the
+// key of certFile and keyFile. When clientCAFile is given the certificates
+// presented by the clients are verified with its CAs. The clients without
+// certificate are still accepted so that the health checks keep working,
+// it's up to the handlers to require a verified certificate. All the files
+// are reloaded when they change on disk.
+func NewTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	r := &certReloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+		dirs:         map[string]*os.File{},
+	}
+	for _, name := range []string{certFile, keyFile, clientCAFile} {
+		if len(name) == 0 {
+			continue
+		}
+		dir, err := filepath.Abs(filepath.Dir(name))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := r.dirs[dir]; ok {
+			continue
+		}
+		if r.dirs[dir], err = os.Open(dir); err != nil {
+			return nil, fmt.Errorf("Unable to open the TLS files directory: %v\n", err)
+		}
+	}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	r.checked = time.Now()
+
+	r.base = &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.getCertificate,
+	}
+	if len(clientCAFile) > 0 {
+		r.base.ClientAuth = tls.VerifyClientCertIfGiven
+		r.base.GetConfigForClient = r.getConfigForClient
+	}
+	return r.base, nil
+}
+
+// readFile reads name through the directory opened beforehand.
+func (r *certReloader) readFile(name string) ([]byte, error) {
+	dirName, err := filepath.Abs(filepath.Dir(name))
+	if err != nil {
+		return nil, err
+	}
+	dir := r.dirs[dirName]
+	fd, err := syscall.Openat(int(dir.Fd()), filepath.Base(name), syscall.O_RDONLY|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: name, Err: err}
+	}
+	file := os.NewFile(uintptr(fd), name)
+	defer file.Close()
+	return ioutil.ReadAll(file)
+}
+
+// reload reads the files again and parses them if they changed.
+func (r *certReloader) reload() error {
+	certPEM, err := r.readFile(r.certFile)
+	if err != nil {
+		return fmt.Errorf("Unable to read the TLS certificate: %v\n", err)
+	}
+	keyPEM, err := r.readFile(r.keyFile)
+	if err != nil {
+		return fmt.Errorf("Unable to read the TLS key: %v\n", err)
+	}
+	if !bytes.Equal(certPEM, r.certPEM) || !bytes.Equal(keyPEM, r.keyPEM) {
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return fmt.Errorf("Unable to load the TLS certificate: %v\n", err)
+		}
+		if r.cert != nil {
+			log.Printf("Reloaded the TLS certificate %s", r.certFile)
+		}
+		r.cert, r.certPEM, r.keyPEM = &cert, certPEM, keyPEM
+	}
+
+	if len(r.clientCAFile) == 0 {
+		return nil
+	}
+	caPEM, err := r.readFile(r.clientCAFile)
+	if err != nil {
+		return fmt.Errorf("Unable to read the client CA: %v\n", err)
+	}
+	if !bytes.Equal(caPEM, r.caPEM) {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return fmt.Errorf("Unable to load the client CA %s", r.clientCAFile)
+		}
+		if r.clientCAs != nil {
+			log.Printf("Reloaded the client CA %s", r.clientCAFile)
+		}
+		r.clientCAs, r.caPEM = pool, caPEM
+	}
+	return nil
+}
+
+// current returns the certificate and the client CAs, reloading them when
+// they were checked more than tlsReloadInterval ago. The previous ones are
+// kept when the reload fails.
+func (r *certReloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if time.Since(r.checked) >= tlsReloadInterval {
+		r.checked = time.Now()
+		if err := r.reload(); err != nil {
+			log.Printf("WARNING: Keeping the previous TLS certificates: %v", err)
+		}
+	}
+	return r.cert, r.clientCAs
+}
+
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert, _ := r.current()
+	return cert, nil
+}
+
+func (r *certReloader) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	_, clientCAs := r.current()
+	config := r.base.Clone()
+	config.GetConfigForClient = nil
+	config.ClientCAs = clientCAs
+	return config, nil
+}
@@ -0,0 +1,161 @@
+package imageserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/openshift/image-inspector/pkg/auth"
+)
+
+// newTestCert returns a certificate signed by parent, self-signed when
+// parent is nil, and its key.
+func newTestCert(t *testing.T, serial int64, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Unable to generate a key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name, Organization: []string{"inspectors"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("Unable to create a certificate: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return cert, key
+}
+
+func writeTestCert(t *testing.T, certFile, keyFile string, cert *x509.Certificate, key *ecdsa.PrivateKey) {
+	if err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), 0600); err != nil {
+		t.Fatalf("Unable to write the certificate: %v", err)
+	}
+	if key == nil {
+		return
+	}
+	der, _ := x509.MarshalECPrivateKey(key)
+	if err := ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		t.Fatalf("Unable to write the key: %v", err)
+	}
+}
+
+func TestTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls-")
+	if err != nil {
+		t.Fatalf("Unable to create a temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	certFile, keyFile, caFile := path.Join(dir, "tls.crt"), path.Join(dir, "tls.key"), path.Join(dir, "ca.crt")
+
+	ca, caKey := newTestCert(t, 1, "ca", nil, nil)
+	serverCert, serverKey := newTestCert(t, 2, "server", ca, caKey)
+	clientCert, clientKey := newTestCert(t, 3, "client", ca, caKey)
+	writeTestCert(t, certFile, keyFile, serverCert, serverKey)
+	writeTestCert(t, caFile, "", ca, nil)
+
+	tlsConfig, err := NewTLSConfig(certFile, keyFile, caFile)
+	if err != nil {
+		t.Fatalf("Unable to create the TLS configuration: %v", err)
+	}
+	filter, _ := auth.NewFilter(auth.Config{ClientCertificates: true})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("ok\n")) })
+	mux.Handle("/metadata", filter.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	}))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unable to listen: %v", err)
+	}
+	server := &http.Server{Handler: mux}
+	go server.Serve(tls.NewListener(listener, tlsConfig))
+	defer server.Close()
+	url := "https://" + listener.Addr().String()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	newClient := func(withCert bool) *http.Client {
+		config := &tls.Config{RootCAs: roots}
+		if withCert {
+			config.Certificates = []tls.Certificate{{Certificate: [][]byte{clientCert.Raw}, PrivateKey: clientKey}}
+		}
+		return &http.Client{Transport: &http.Transport{TLSClientConfig: config, DisableKeepAlives: true}}
+	}
+
+	for k, test := range map[string]struct {
+		path     string
+		withCert bool
+		status   int
+	}{
+		"healthz without client certificate":  {"/healthz", false, http.StatusOK},
+		"metadata without client certificate": {"/metadata", false, http.StatusUnauthorized},
+		"metadata with client certificate":    {"/metadata", true, http.StatusOK},
+	} {
+		resp, err := newClient(test.withCert).Get(url + test.path)
+		if err != nil {
+			t.Errorf("%s: unable to get %s: %v", k, test.path, err)
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode != test.status {
+			t.Errorf("%s: expected status %d but got %d", k, test.status, resp.StatusCode)
+		}
+		if resp.TLS.PeerCertificates[0].SerialNumber.Int64() != 2 {
+			t.Errorf("%s: unexpected server certificate", k)
+		}
+	}
+
+	// a certificate replaced on disk is served by the next connections
+	defer func(interval time.Duration) { tlsReloadInterval = interval }(tlsReloadInterval)
+	tlsReloadInterval = 0
+	renewedCert, renewedKey := newTestCert(t, 4, "server", ca, caKey)
+	writeTestCert(t, certFile, keyFile, renewedCert, renewedKey)
+	resp, err := newClient(false).Get(url + "/healthz")
+	if err != nil {
+		t.Fatalf("Unable to get /healthz after the renewal: %v", err)
+	}
+	resp.Body.Close()
+	if resp.TLS.PeerCertificates[0].SerialNumber.Int64() != 4 {
+		t.Errorf("The renewed certificate should have been served")
+	}
+
+	// a broken certificate on disk keeps the previous one
+	if err := ioutil.WriteFile(certFile, []byte("broken"), 0600); err != nil {
+		t.Fatalf("Unable to break the certificate: %v", err)
+	}
+	resp, err = newClient(false).Get(url + "/healthz")
+	if err != nil {
+		t.Fatalf("Unable to get /healthz with a broken certificate on disk: %v", err)
+	}
+	resp.Body.Close()
+	if resp.TLS.PeerCertificates[0].SerialNumber.Int64() != 4 {
+		t.Errorf("The previous certificate should have been kept")
+	}
+
+	if _, err := NewTLSConfig(certFile, keyFile, caFile); err == nil {
+		t.Errorf("Loading a broken certificate should have failed")
+	}
+}
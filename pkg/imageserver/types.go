@@ -1,15 +1,27 @@
 package imageserver
 
 import (
+	"time"
+
 	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/auth"
+	"github.com/openshift/image-inspector/pkg/progress"
 )
 
 // ImageServer abstracts the serving of image information.
 type ImageServer interface {
-	// ServeImage Serves the image
-	ServeImage(meta *iiapi.InspectorMetadata,
+	// Start starts serving the progress of the inspection in the background,
+	// before the image is inspected
+	Start(tracker *progress.Tracker) error
+	// ServeImage Serves the image, once inspected, marking the inspection
+	// ready, until the server is stopped
+	ServeImage(report *iiapi.InspectorReport,
+		layerFiles iiapi.LayerFiles,
 		scanReport []byte,
 		htmlScanReport []byte) error
+	// ServeFailure keeps serving the progress of a failed inspection until
+	// the server is stopped
+	ServeFailure() error
 }
 
 // ImageServerOptions is used to configure an image server.
@@ -38,4 +50,38 @@ type ImageServerOptions struct {
 	HTMLScanReport bool
 	// HTMLScanReportURL url for the scan html report
 	HTMLScanReportURL string
+	// LayersURL is the url of the per-layer breakdown of the image
+	LayersURL string
+	// EfficiencyURL is the url of the image efficiency report
+	EfficiencyURL string
+	// SARIFURL is the url of the SARIF report of the scan findings
+	SARIFURL string
+	// JUnitURL is the url of the JUnit XML report of the scan findings
+	JUnitURL string
+	// AttestationURL is the url of the signed attestation of the inspection
+	AttestationURL string
+	// StatusURL is the url of the progress of the inspection
+	StatusURL string
+	// EventsURL is the url of the stream of server-sent events of the
+	// progress of the inspection
+	EventsURL string
+	// SearchURL is the url of the search of the content
+	SearchURL string
+	// MetricsURL is the url of the Prometheus metrics
+	MetricsURL string
+	// Auth authenticates and authorizes the requests, nil to serve everybody
+	Auth *auth.Filter
+	// TLSCertFile and TLSKeyFile are the certificate and the key to serve with
+	// TLS, plain HTTP is served when empty
+	TLSCertFile string
+	TLSKeyFile  string
+	// TLSClientCAFile are the CAs verifying the client certificates, if any
+	TLSClientCAFile string
+	// ServeTimeout stops serving after this long, 0 serves forever
+	ServeTimeout time.Duration
+	// IdleTimeout stops serving when no request is received for this long
+	IdleTimeout time.Duration
+	// ExitAfterDownloads stops serving once the scan report was downloaded this
+	// many times, 0 never does
+	ExitAfterDownloads int
 }
@@ -1,15 +1,20 @@
 package imageserver
 
 import (
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
+	"path"
+	"sync/atomic"
 	"syscall"
 
-	"golang.org/x/net/webdav"
-
 	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/auth"
+	"github.com/openshift/image-inspector/pkg/metrics"
+	"github.com/openshift/image-inspector/pkg/output"
+	"github.com/openshift/image-inspector/pkg/progress"
 )
 
 const (
@@ -22,6 +27,10 @@ const (
 type webdavImageServer struct {
 	opts   ImageServerOptions
 	chroot bool
+	// server serves the image once started
+	server *Server
+	// tracker is the progress of the inspection
+	tracker *progress.Tracker
 }
 
 // ensures this always implements the interface or fail compilation.
@@ -35,11 +44,77 @@ func NewWebdavImageServer(opts ImageServerOptions, chroot bool) ImageServer {
 	}
 }
 
+// Start starts serving the health checks, the metrics and the progress of
+// the inspection tracked by tracker. The rest of the API answers 503 until
+// the image is served.
+func (s *webdavImageServer) Start(tracker *progress.Tracker) error {
+	// the TLS files are opened before changing root
+	var tlsConfig *tls.Config
+	if len(s.opts.TLSCertFile) > 0 {
+		var err error
+		if tlsConfig, err = NewTLSConfig(s.opts.TLSCertFile, s.opts.TLSKeyFile, s.opts.TLSClientCAFile); err != nil {
+			return err
+		}
+	}
+	s.tracker = tracker
+
+	http.HandleFunc(s.opts.HealthzURL, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok\n"))
+	})
+
+	http.Handle(s.opts.APIURL, s.opts.Auth.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
+		body, err := json.MarshalIndent(s.opts.APIVersions, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Write(body)
+	}))
+
+	http.Handle(s.opts.APIURL+"/", s.opts.Auth.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
+		if current := tracker.Get(); !current.Done() {
+			w.Header().Set("Retry-After", "10")
+			http.Error(w, fmt.Sprintf("The image is being inspected: %s", current.Stage), http.StatusServiceUnavailable)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+
+	http.Handle(s.opts.StatusURL, s.opts.Auth.Require(auth.AccessMetadata, NewStatusHandler(tracker)))
+	http.Handle(s.opts.EventsURL, s.opts.Auth.Require(auth.AccessMetadata, NewEventsHandler(tracker)))
+	http.Handle(s.opts.MetricsURL, s.opts.Auth.Require(auth.AccessMetadata, metrics.DefaultRegistry))
+
+	s.server = NewServer(s.opts.ServePath, metrics.InstrumentHandler(http.DefaultServeMux), tlsConfig)
+	s.server.ServeTimeout = s.opts.ServeTimeout
+	s.server.IdleTimeout = s.opts.IdleTimeout
+	s.server.IdleExemptPaths = []string{s.opts.HealthzURL, s.opts.MetricsURL}
+	if err := s.server.Start(); err != nil {
+		return err
+	}
+	scheme := "http"
+	if tlsConfig != nil {
+		scheme = "https"
+	}
+	log.Printf("Serving the progress of the inspection on %s://%s%s", scheme, s.opts.ServePath, s.opts.StatusURL)
+	return nil
+}
+
 // ServeImage Serves the image.
-func (s *webdavImageServer) ServeImage(meta *iiapi.InspectorMetadata,
+func (s *webdavImageServer) ServeImage(report *iiapi.InspectorReport,
+	layerFiles iiapi.LayerFiles,
 	scanReport []byte,
 	htmlScanReport []byte) error {
 
+	meta := report.Metadata
+
+	if s.server == nil {
+		if err := s.Start(progress.NewTracker(report.Image)); err != nil {
+			return err
+		}
+	}
+	server := s.server
+	var downloads int32
+
 	servePath := s.opts.ImageServeURL
 	if s.chroot {
 		if err := syscall.Chroot(s.opts.ImageServeURL); err != nil {
@@ -54,31 +129,21 @@ func (s *webdavImageServer) ServeImage(meta *iiapi.InspectorMetadata,
 
 	log.Printf("Serving image content %s on webdav://%s%s", s.opts.ImageServeURL, s.opts.ServePath, s.opts.ContentURL)
 
-	http.HandleFunc(s.opts.HealthzURL, func(w http.ResponseWriter, r *http.Request) {
-		w.Write([]byte("ok\n"))
-	})
-
-	http.HandleFunc(s.opts.APIURL, func(w http.ResponseWriter, r *http.Request) {
-		body, err := json.MarshalIndent(s.opts.APIVersions, "", "  ")
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-		w.Write(body)
-	})
-
-	http.HandleFunc(s.opts.MetadataURL, func(w http.ResponseWriter, r *http.Request) {
+	http.Handle(s.opts.MetadataURL, s.opts.Auth.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
 		body, err := json.MarshalIndent(meta, "", "  ")
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 		w.Write(body)
-	})
+	}))
 
-	http.HandleFunc(s.opts.ScanReportURL, func(w http.ResponseWriter, r *http.Request) {
+	http.Handle(s.opts.ScanReportURL, s.opts.Auth.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
 		if s.opts.ScanType != "" && meta.OpenSCAP.Status == iiapi.StatusSuccess {
-			w.Write(scanReport)
+			if _, err := w.Write(scanReport); err == nil && s.opts.ExitAfterDownloads > 0 &&
+				atomic.AddInt32(&downloads, 1) == int32(s.opts.ExitAfterDownloads) {
+				server.Stop(fmt.Sprintf("the scan report was downloaded %d times", s.opts.ExitAfterDownloads))
+			}
 		} else {
 			if meta.OpenSCAP.Status == iiapi.StatusError {
 				http.Error(w, fmt.Sprintf("OpenSCAP Error: %s", meta.OpenSCAP.ErrorMessage),
@@ -87,9 +152,9 @@ func (s *webdavImageServer) ServeImage(meta *iiapi.InspectorMetadata,
 				http.Error(w, "OpenSCAP option was not chosen", http.StatusNotFound)
 			}
 		}
-	})
+	}))
 
-	http.HandleFunc(s.opts.HTMLScanReportURL, func(w http.ResponseWriter, r *http.Request) {
+	http.Handle(s.opts.HTMLScanReportURL, s.opts.Auth.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
 		if s.opts.ScanType != "" && meta.OpenSCAP.Status == iiapi.StatusSuccess && s.opts.HTMLScanReport {
 			w.Write(htmlScanReport)
 		} else {
@@ -100,13 +165,90 @@ func (s *webdavImageServer) ServeImage(meta *iiapi.InspectorMetadata,
 				http.Error(w, "OpenSCAP option was not chosen", http.StatusNotFound)
 			}
 		}
-	})
+	}))
 
-	http.Handle(s.opts.ContentURL, &webdav.Handler{
-		Prefix:     s.opts.ContentURL,
-		FileSystem: webdav.Dir(servePath),
-		LockSystem: webdav.NewMemLS(),
-	})
+	http.Handle(s.opts.LayersURL, s.opts.Auth.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
+		if layerFiles == nil {
+			http.Error(w, "The image was not extracted layer by layer", http.StatusNotFound)
+			return
+		}
+		var content interface{} = struct {
+			Layers []iiapi.ImageLayer
+			Files  iiapi.LayerFiles
+		}{meta.Layers, layerFiles}
+		if p := r.URL.Query().Get("path"); len(p) > 0 {
+			layer := meta.LayerOf(layerFiles, path.Clean("/"+p))
+			if layer == nil {
+				http.Error(w, fmt.Sprintf("%s was not found in any layer", p), http.StatusNotFound)
+				return
+			}
+			content = layer
+		}
+		body, err := json.MarshalIndent(content, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Write(body)
+	}))
+
+	http.Handle(s.opts.EfficiencyURL, s.opts.Auth.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
+		if meta.Efficiency == nil {
+			http.Error(w, "The image was not extracted layer by layer", http.StatusNotFound)
+			return
+		}
+		body, err := json.MarshalIndent(meta.Efficiency, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Write(body)
+	}))
+
+	http.Handle(s.opts.SARIFURL, s.opts.Auth.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/sarif+json")
+		if err := output.Render(w, "sarif", report); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}))
+
+	http.Handle(s.opts.JUnitURL, s.opts.Auth.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		if err := output.Render(w, "junit", report); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}))
 
-	return http.ListenAndServe(s.opts.ServePath, nil)
+	http.Handle(s.opts.AttestationURL, s.opts.Auth.RequireFunc(auth.AccessMetadata, func(w http.ResponseWriter, r *http.Request) {
+		if report.Attestation == nil {
+			http.Error(w, "The inspection was not attested", http.StatusNotFound)
+			return
+		}
+		body, err := json.MarshalIndent(report.Attestation, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	}))
+
+	fs := NewDirFileSystem(servePath)
+	http.Handle(s.opts.ContentURL, s.opts.Auth.Require(auth.AccessContent, NewContentHandler(s.opts.ContentURL, fs)))
+	http.Handle(s.opts.SearchURL, s.opts.Auth.Require(auth.AccessContent, NewSearchHandler(fs)))
+
+	s.tracker.SetStage(iiapi.ProgressReady)
+	server.ArmTimeouts()
+	return server.Wait()
+}
+
+// ServeFailure serves the status of the failed inspection until a timeout
+// expires or the server is stopped.
+func (s *webdavImageServer) ServeFailure() error {
+	if s.server == nil {
+		return nil
+	}
+	log.Printf("Serving the status of the failed inspection on %s%s", s.opts.ServePath, s.opts.StatusURL)
+	s.server.ArmTimeouts()
+	return s.server.Wait()
 }
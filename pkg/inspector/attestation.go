@@ -0,0 +1,29 @@
+package inspector
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/openshift/image-inspector/pkg/attestation"
+)
+
+// attest signs the attestation of the inspection with the option's
+// attestation key. It states the summary of the scan of the image of the
+// manifest the image was pulled by.
+func (i *defaultImageInspector) attest() error {
+	signer, err := attestation.LoadSigner(i.opts.AttestationKey)
+	if err != nil {
+		return err
+	}
+	statement, err := attestation.NewStatement(i.Report(), time.Now())
+	if err != nil {
+		return fmt.Errorf("Unable to attest the inspection: %v\n", err)
+	}
+	if i.attestation, err = signer.Sign(statement); err != nil {
+		return fmt.Errorf("Unable to attest the inspection: %v\n", err)
+	}
+	log.Printf("Attested the inspection of %s@%s with the key %s", statement.Subject[0].Name,
+		statement.Predicate.ImageDigest, signer.KeyID())
+	return nil
+}
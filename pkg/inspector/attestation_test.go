@@ -0,0 +1,41 @@
+package inspector
+
+import (
+	"strings"
+	"testing"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/attestation"
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+)
+
+func TestAttest(t *testing.T) {
+	opts := iicmd.NewDefaultImageInspectorOptions()
+	opts.Image = "docker.io/library/fedora@sha256:0000"
+	opts.AttestationKey = "../attestation/test/attestation.key"
+	ii := &defaultImageInspector{opts: *opts}
+	ii.meta.Provenance = &iiapi.ImageProvenance{RepoDigest: "docker.io/library/fedora@sha256:0000"}
+	ii.findings = []iiapi.Finding{{ID: "CVE-2017-1000", Severity: "Critical", Result: iiapi.ResultFail}}
+	ii.sbom = []byte(`{"bomFormat":"CycloneDX","components":[]}`)
+
+	if err := ii.attest(); err != nil {
+		t.Fatalf("Unable to attest the inspection: %v", err)
+	}
+	report := ii.Report()
+	if report.Attestation == nil {
+		t.Fatalf("The attestation is missing from the report")
+	}
+	statement, err := attestation.Verify(report.Attestation, "../attestation/test/attestation.pub")
+	if err != nil {
+		t.Fatalf("Unable to verify the attestation: %v", err)
+	}
+	if statement.Predicate.ImageDigest != "sha256:0000" || statement.Predicate.Summary.Vulnerabilities["critical"] != 1 ||
+		!strings.HasPrefix(statement.Predicate.SBOMDigest, "sha256:") {
+		t.Errorf("Unexpected attested statement %+v", statement)
+	}
+
+	ii = &defaultImageInspector{opts: *opts}
+	if err := ii.attest(); err == nil {
+		t.Errorf("An image without digest can't be attested")
+	}
+}
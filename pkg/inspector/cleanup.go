@@ -0,0 +1,111 @@
+package inspector
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+)
+
+// AT_REMOVEDIR makes unlinkat remove a directory
+const AT_REMOVEDIR = 0x200
+
+// dirRemover removes directories through file descriptors of their parents
+// opened beforehand, so that they can be removed after a chroot.
+type dirRemover struct {
+	parents []*os.File
+	names   []string
+}
+
+// newDirRemover returns a remover of dirs, ignoring the empty ones.
+func newDirRemover(dirs ...string) (*dirRemover, error) {
+	r := &dirRemover{}
+	for _, dir := range dirs {
+		if len(dir) == 0 {
+			continue
+		}
+		dir, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, err
+		}
+		if dir == "/" {
+			return nil, fmt.Errorf("Refusing to remove /")
+		}
+		parent, err := os.Open(filepath.Dir(dir))
+		if err != nil {
+			r.Close()
+			return nil, fmt.Errorf("Unable to open the parent of %s: %v\n", dir, err)
+		}
+		r.parents = append(r.parents, parent)
+		r.names = append(r.names, filepath.Base(dir))
+	}
+	return r, nil
+}
+
+// RemoveAll removes the directories and all their content.
+func (r *dirRemover) RemoveAll() error {
+	var firstErr error
+	for idx, parent := range r.parents {
+		err := removeAllAt(int(parent.Fd()), r.names[idx])
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("Unable to remove %s: %v\n", filepath.Join(parent.Name(), r.names[idx]), err)
+		}
+	}
+	return firstErr
+}
+
+// Close closes the parent directories.
+func (r *dirRemover) Close() {
+	for _, parent := range r.parents {
+		parent.Close()
+	}
+	r.parents, r.names = nil, nil
+}
+
+// removeAllAt removes name, relative to the directory dirfd, and all its
+// content without following any symlink.
+func removeAllAt(dirfd int, name string) error {
+	err := syscall.Unlinkat(dirfd, name)
+	if err == nil || err == syscall.ENOENT {
+		return nil
+	}
+	// it's a directory, or a file we can't remove
+	fd, err := syscall.Openat(dirfd, name, syscall.O_RDONLY|syscall.O_DIRECTORY|syscall.O_NOFOLLOW|syscall.O_CLOEXEC, 0)
+	if err != nil {
+		if err == syscall.ENOENT {
+			return nil
+		}
+		return err
+	}
+	dir := os.NewFile(uintptr(fd), name)
+	defer dir.Close()
+	// all the names are read first since removing entries while reading the
+	// directory may skip some of them
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return err
+	}
+	for _, child := range names {
+		if err := removeAllAt(fd, child); err != nil {
+			return err
+		}
+	}
+	if err := unlinkDirAt(dirfd, name); err != nil && err != syscall.ENOENT {
+		return err
+	}
+	return nil
+}
+
+// unlinkDirAt removes the empty directory name relative to dirfd.
+func unlinkDirAt(dirfd int, name string) error {
+	p, err := syscall.BytePtrFromString(name)
+	if err != nil {
+		return err
+	}
+	_, _, errno := syscall.Syscall(syscall.SYS_UNLINKAT, uintptr(dirfd), uintptr(unsafe.Pointer(p)), AT_REMOVEDIR)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package inspector
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+	"github.com/openshift/image-inspector/pkg/progress"
+)
+
+func TestDirRemover(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "cleanup-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	outside := filepath.Join(tmp, "outside")
+	dst := filepath.Join(tmp, "dst")
+	results := filepath.Join(tmp, "results")
+	for _, dir := range []string{outside, filepath.Join(dst, "etc", "ssl"), results} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, file := range []string{filepath.Join(outside, "keep"), filepath.Join(dst, "etc", "ssl", "cert"), filepath.Join(results, "report.xml")} {
+		if err := ioutil.WriteFile(file, []byte("content"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink(outside, filepath.Join(dst, "etc", "outside")); err != nil {
+		t.Fatal(err)
+	}
+
+	remover, err := newDirRemover(dst, "", results, filepath.Join(tmp, "nosuchdir"))
+	if err != nil {
+		t.Fatalf("unexpected error creating the remover: %v", err)
+	}
+	defer remover.Close()
+	if err := remover.RemoveAll(); err != nil {
+		t.Fatalf("unexpected error removing: %v", err)
+	}
+	for _, dir := range []string{dst, results} {
+		if _, err := os.Lstat(dir); !os.IsNotExist(err) {
+			t.Errorf("%s should have been removed", dir)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(outside, "keep")); err != nil {
+		t.Errorf("the target of a symlink should not have been removed: %v", err)
+	}
+
+	if _, err := newDirRemover("/"); err == nil {
+		t.Errorf("removing / should have failed")
+	}
+}
+
+// fakeImageServer serves nothing and returns at once.
+type fakeImageServer struct{}
+
+func (s *fakeImageServer) Start(tracker *progress.Tracker) error {
+	return nil
+}
+
+func (s *fakeImageServer) ServeImage(report *iiapi.InspectorReport, layerFiles iiapi.LayerFiles, scanReport []byte, htmlScanReport []byte) error {
+	return nil
+}
+
+func (s *fakeImageServer) ServeFailure() error {
+	return nil
+}
+
+func TestServeAndCleanUp(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "cleanup-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	for k, v := range map[string]struct {
+		keep           bool
+		resultsRemoved bool
+	}{
+		"removed": {resultsRemoved: true},
+		"kept":    {keep: true},
+	} {
+		// the destination path exists with user data, the results directory
+		// is created by the inspector
+		existing := filepath.Join(tmp, k, "data")
+		if err := os.MkdirAll(existing, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(existing, "precious"), []byte("content"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		opts := iicmd.NewDefaultImageInspectorOptions()
+		opts.Keep = v.keep
+		ii := &defaultImageInspector{opts: *opts, imageServer: &fakeImageServer{}}
+		if ii.opts.DstPath, err = ii.createOutputDir(existing, TEMP_DIR_PREFIX); err != nil {
+			t.Fatal(err)
+		}
+		if ii.opts.ScanResultsDir, err = ii.createOutputDir(filepath.Join(tmp, k, "results"), TEMP_DIR_PREFIX); err != nil {
+			t.Fatal(err)
+		}
+		if err := ii.serveAndCleanUp(nil, nil); err != nil {
+			t.Errorf("%s: unexpected error %v", k, err)
+		}
+		if _, err := os.Stat(filepath.Join(existing, "precious")); err != nil {
+			t.Errorf("%s: the existing destination path should have been kept: %v", k, err)
+		}
+		if _, err := os.Stat(ii.opts.ScanResultsDir); v.resultsRemoved != os.IsNotExist(err) {
+			t.Errorf("%s: expected the created results directory to be removed %t: %v", k, v.resultsRemoved, err)
+		}
+	}
+}
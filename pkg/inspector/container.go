@@ -0,0 +1,99 @@
+package inspector
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"sort"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	iiruntime "github.com/openshift/image-inspector/pkg/runtime"
+)
+
+// changeKinds are the kinds of the container changes reported by docker.
+var changeKinds = map[docker.ChangeType]iiapi.ContainerChangeKind{
+	docker.ChangeModify: iiapi.ChangeModified,
+	docker.ChangeAdd:    iiapi.ChangeAdded,
+	docker.ChangeDelete: iiapi.ChangeDeleted,
+}
+
+// NewContainerMetadata returns the metadata of container whose paths
+// changed from its image are changes.
+func NewContainerMetadata(container *docker.Container, changes []docker.Change) *iiapi.ContainerMetadata {
+	meta := &iiapi.ContainerMetadata{
+		ID:        container.ID,
+		Name:      container.Name,
+		Image:     container.Image,
+		Created:   container.Created,
+		StartedAt: container.State.StartedAt,
+		Status:    container.State.Status,
+		Running:   container.State.Running,
+		Changes:   make([]iiapi.ContainerChange, 0, len(changes)),
+	}
+	if container.Config != nil && len(container.Config.Image) > 0 {
+		meta.Image = container.Config.Image
+	}
+	for _, change := range changes {
+		meta.Changes = append(meta.Changes, iiapi.ContainerChange{Path: change.Path, Kind: changeKinds[change.Kind]})
+	}
+	sort.Slice(meta.Changes, func(i, j int) bool {
+		return meta.Changes[i].Path < meta.Changes[j].Path
+	})
+	return meta
+}
+
+// extractContainer extracts the filesystem of the option's container, as it
+// is now, to the option's destination path. The container and the paths it
+// changed from its image are recorded in the metadata, the image being the
+// one the container runs, which is then the inspected image.
+func (i *defaultImageInspector) extractContainer(rt iiruntime.Runtime) (*docker.Image, error) {
+	container, err := rt.InspectContainer(i.opts.Container)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get %s container information: %v\n", rt.Name(), err)
+	}
+
+	imageMetadata, err := rt.InspectImage(container.Image)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get %s image information: %v\n", rt.Name(), err)
+	}
+
+	changes, err := rt.ContainerChanges(container.ID)
+	if err != nil {
+		return imageMetadata, fmt.Errorf("Unable to get the changes of the container: %v\n", err)
+	}
+	i.meta.Container = NewContainerMetadata(container, changes)
+	i.opts.Image = i.meta.Container.Image
+
+	if i.opts.DstPath, err = i.createOutputDir(i.opts.DstPath, TEMP_DIR_PREFIX); err != nil {
+		return imageMetadata, err
+	}
+
+	reader, writer := io.Pipe()
+	// handle closing the reader/writer in the method that creates them
+	defer writer.Close()
+	defer reader.Close()
+
+	log.Printf("Extracting container %s (%s) of image %s to %s", container.ID, i.meta.Container.Status,
+		i.opts.Image, i.opts.DstPath)
+
+	errorChannel := make(chan error)
+	go func() {
+		err := rt.ExportContainer(container.ID, writer)
+		writer.CloseWithError(err)
+		errorChannel <- err
+	}()
+
+	handleTarStream(reader, i.opts.DstPath, i.progress)
+	// drain whatever is left so that the export can finish
+	io.Copy(ioutil.Discard, reader)
+
+	if err = <-errorChannel; err != nil {
+		return imageMetadata, fmt.Errorf("Unable to extract container: %v\n", err)
+	}
+	log.Printf("Container %s changed %d paths from its image", container.ID, len(i.meta.Container.Changes))
+
+	return imageMetadata, nil
+}
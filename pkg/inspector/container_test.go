@@ -0,0 +1,109 @@
+package inspector
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+	iiruntime "github.com/openshift/image-inspector/pkg/runtime"
+)
+
+// fakeContainerRuntime knows a single container, web, running fedora.
+type fakeContainerRuntime struct {
+	iiruntime.Runtime
+	rootfs []byte
+}
+
+func (r *fakeContainerRuntime) Name() string {
+	return "fake"
+}
+
+func (r *fakeContainerRuntime) InspectContainer(id string) (*docker.Container, error) {
+	if id != "web" {
+		return nil, fmt.Errorf("No such container: %s", id)
+	}
+	return &docker.Container{
+		ID:      "1234",
+		Name:    "/web",
+		Image:   "sha256:5678",
+		Created: time.Date(2017, 7, 1, 0, 0, 0, 0, time.UTC),
+		Config:  &docker.Config{Image: "fedora:26"},
+		State:   docker.State{Status: "running", Running: true},
+	}, nil
+}
+
+func (r *fakeContainerRuntime) InspectImage(image string) (*docker.Image, error) {
+	if image != "sha256:5678" {
+		return nil, fmt.Errorf("No such image: %s", image)
+	}
+	return &docker.Image{ID: image, RepoTags: []string{"fedora:26"}}, nil
+}
+
+func (r *fakeContainerRuntime) ContainerChanges(id string) ([]docker.Change, error) {
+	return []docker.Change{
+		{Path: "/tmp/dropped", Kind: docker.ChangeAdd},
+		{Path: "/etc/motd", Kind: docker.ChangeDelete},
+		{Path: "/etc", Kind: docker.ChangeModify},
+	}, nil
+}
+
+func (r *fakeContainerRuntime) ExportContainer(id string, output io.Writer) error {
+	_, err := output.Write(r.rootfs)
+	return err
+}
+
+func TestExtractContainer(t *testing.T) {
+	dstDir, err := ioutil.TempDir("", "container-dst-")
+	if err != nil {
+		t.Fatalf("Unable to create destination dir: %v", err)
+	}
+	defer os.RemoveAll(dstDir)
+
+	rt := &fakeContainerRuntime{rootfs: mkTar(t, []tarEntry{
+		{name: "etc/", typeflag: tar.TypeDir},
+		{name: "tmp/", typeflag: tar.TypeDir},
+		{name: "tmp/dropped", typeflag: tar.TypeReg, content: "payload"},
+	})}
+	opts := iicmd.NewDefaultImageInspectorOptions()
+	opts.Container = "web"
+	opts.DstPath = dstDir
+	ii := &defaultImageInspector{opts: *opts}
+
+	image, err := ii.extractContainer(rt)
+	if err != nil {
+		t.Fatalf("Unable to extract the container: %v", err)
+	}
+	if image.ID != "sha256:5678" || ii.opts.Image != "fedora:26" {
+		t.Errorf("Expected the image of the container but got %s %s", image.ID, ii.opts.Image)
+	}
+	container := ii.meta.Container
+	if container == nil || container.ID != "1234" || container.Image != "fedora:26" || !container.Running {
+		t.Fatalf("Unexpected container metadata %+v", container)
+	}
+	expected := []iiapi.ContainerChange{
+		{Path: "/etc", Kind: iiapi.ChangeModified},
+		{Path: "/etc/motd", Kind: iiapi.ChangeDeleted},
+		{Path: "/tmp/dropped", Kind: iiapi.ChangeAdded},
+	}
+	if fmt.Sprint(container.Changes) != fmt.Sprint(expected) {
+		t.Errorf("Expected the changes %v but got %v", expected, container.Changes)
+	}
+	if content, err := ioutil.ReadFile(path.Join(dstDir, "tmp/dropped")); err != nil || string(content) != "payload" {
+		t.Errorf("The files of the container were not extracted: %q %v", content, err)
+	}
+
+	ii = &defaultImageInspector{opts: *opts}
+	ii.opts.Container = "db"
+	if _, err := ii.extractContainer(rt); err == nil {
+		t.Errorf("Extracting an unknown container should have failed")
+	}
+}
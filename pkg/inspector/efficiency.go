@@ -0,0 +1,222 @@
+package inspector
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	util "github.com/openshift/image-inspector/pkg/util"
+)
+
+const (
+	// EFFICIENCY_TOP_ENTRIES is the maximum number of entries of each list in
+	// the efficiency report.
+	EFFICIENCY_TOP_ENTRIES = 20
+)
+
+// layerFileRecord is the state of a path while walking the image layers.
+type layerFileRecord struct {
+	layer  int
+	size   int64
+	digest string
+}
+
+// efficiencyAnalyzer walks the image layers, base layer first, keeping track
+// of the bytes that are hidden by later layers.
+type efficiencyAnalyzer struct {
+	files       map[string]layerFileRecord
+	layerBytes  []int64
+	occurrences map[string]int
+	wasted      map[string]int64
+	deleted     map[string]bool
+}
+
+func newEfficiencyAnalyzer() *efficiencyAnalyzer {
+	return &efficiencyAnalyzer{
+		files:       map[string]layerFileRecord{},
+		occurrences: map[string]int{},
+		wasted:      map[string]int64{},
+		deleted:     map[string]bool{},
+	}
+}
+
+// analyzeLayerEfficiency builds the efficiency report of the spooled layer
+// tarballs, given base layer first.
+func analyzeLayerEfficiency(layerFiles []string) (*iiapi.ImageEfficiency, error) {
+	analyzer := newEfficiencyAnalyzer()
+	for idx, fileName := range layerFiles {
+		tr, file, err := openLayerFile(fileName)
+		if err != nil {
+			return nil, err
+		}
+		err = analyzer.addLayer(tr, idx)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return analyzer.report(), nil
+}
+
+// addLayer accounts for the layer idx.
+func (a *efficiencyAnalyzer) addLayer(tr *tar.Reader, idx int) error {
+	a.layerBytes = append(a.layerBytes, 0)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("Unable to read layer %d: %v\n", idx, err)
+		}
+
+		name := path.Clean("/" + hdr.Name)
+		dir, base := path.Split(name)
+		switch {
+		case name == "/" || hdr.Typeflag == tar.TypeDir:
+			continue
+		case base == WHITEOUT_OPAQUE_DIR:
+			a.hide(path.Clean(dir), idx, true)
+			continue
+		case strings.HasPrefix(base, WHITEOUT_PREFIX):
+			a.hide(path.Join(dir, strings.TrimPrefix(base, WHITEOUT_PREFIX)), idx, false)
+			continue
+		}
+
+		record := layerFileRecord{layer: idx}
+		if hdr.Typeflag == tar.TypeReg || hdr.Typeflag == tar.TypeRegA {
+			hash := sha256.New()
+			if _, err := io.Copy(hash, tr); err != nil {
+				return fmt.Errorf("Unable to read layer %d: %v\n", idx, err)
+			}
+			record.size = hdr.Size
+			record.digest = hex.EncodeToString(hash.Sum(nil))
+		}
+		if old, ok := a.files[name]; ok {
+			a.wasted[name] += old.size
+		}
+		a.files[name] = record
+		a.occurrences[name]++
+		delete(a.deleted, name)
+		a.layerBytes[idx] += record.size
+	}
+}
+
+// hide accounts for the paths removed by a whiteout of the layer idx. When
+// opaque is true only the content of name added by lower layers is removed.
+func (a *efficiencyAnalyzer) hide(name string, idx int, opaque bool) {
+	for file, record := range a.files {
+		if opaque && (record.layer == idx || !strings.HasPrefix(file, name+"/")) {
+			continue
+		}
+		if !opaque && file != name && !strings.HasPrefix(file, name+"/") {
+			continue
+		}
+		a.wasted[file] += record.size
+		a.deleted[file] = true
+		delete(a.files, file)
+	}
+}
+
+// report summarizes the walked layers.
+func (a *efficiencyAnalyzer) report() *iiapi.ImageEfficiency {
+	report := &iiapi.ImageEfficiency{
+		LayerBytes:   a.layerBytes,
+		WastedFiles:  []iiapi.WastedFile{},
+		Duplicates:   []iiapi.DuplicateFiles{},
+		LargestFiles: []iiapi.PathSize{},
+		LargestDirs:  []iiapi.PathSize{},
+	}
+	for _, bytes := range a.layerBytes {
+		report.TotalBytes += bytes
+	}
+
+	for name, occurrences := range a.occurrences {
+		if !a.deleted[name] && occurrences < 2 {
+			continue
+		}
+		report.WastedBytes += a.wasted[name]
+		report.WastedFiles = append(report.WastedFiles, iiapi.WastedFile{
+			Path:        name,
+			Occurrences: occurrences,
+			WastedBytes: a.wasted[name],
+			Deleted:     a.deleted[name],
+		})
+	}
+
+	byDigest := map[string][]string{}
+	dirs := map[string]int64{}
+	for name, record := range a.files {
+		if record.size > 0 {
+			byDigest[record.digest] = append(byDigest[record.digest], name)
+		}
+		report.LargestFiles = append(report.LargestFiles, iiapi.PathSize{Path: name, Size: record.size})
+		for dir := path.Dir(name); ; dir = path.Dir(dir) {
+			dirs[dir] += record.size
+			if dir == "/" {
+				break
+			}
+		}
+	}
+	for digest, paths := range byDigest {
+		if len(paths) < 2 {
+			continue
+		}
+		sort.Strings(paths)
+		size := a.files[paths[0]].size
+		duplicate := iiapi.DuplicateFiles{
+			Digest:      "sha256:" + digest,
+			Size:        size,
+			Paths:       paths,
+			WastedBytes: size * int64(len(paths)-1),
+		}
+		report.WastedBytes += duplicate.WastedBytes
+		report.Duplicates = append(report.Duplicates, duplicate)
+	}
+	for dir, size := range dirs {
+		report.LargestDirs = append(report.LargestDirs, iiapi.PathSize{Path: dir, Size: size})
+	}
+
+	report.WastedFileCount = len(report.WastedFiles)
+	report.DuplicateCount = len(report.Duplicates)
+	report.Score = 1
+	if report.TotalBytes > 0 {
+		report.Score = float64(report.TotalBytes-report.WastedBytes) / float64(report.TotalBytes)
+	}
+
+	sort.Slice(report.WastedFiles, func(i, j int) bool {
+		if report.WastedFiles[i].WastedBytes != report.WastedFiles[j].WastedBytes {
+			return report.WastedFiles[i].WastedBytes > report.WastedFiles[j].WastedBytes
+		}
+		return report.WastedFiles[i].Path < report.WastedFiles[j].Path
+	})
+	sort.Slice(report.Duplicates, func(i, j int) bool {
+		if report.Duplicates[i].WastedBytes != report.Duplicates[j].WastedBytes {
+			return report.Duplicates[i].WastedBytes > report.Duplicates[j].WastedBytes
+		}
+		return report.Duplicates[i].Digest < report.Duplicates[j].Digest
+	})
+	sortPathSizes(report.LargestFiles)
+	sortPathSizes(report.LargestDirs)
+
+	report.WastedFiles = report.WastedFiles[:util.Min(len(report.WastedFiles), EFFICIENCY_TOP_ENTRIES)]
+	report.Duplicates = report.Duplicates[:util.Min(len(report.Duplicates), EFFICIENCY_TOP_ENTRIES)]
+	report.LargestFiles = report.LargestFiles[:util.Min(len(report.LargestFiles), EFFICIENCY_TOP_ENTRIES)]
+	report.LargestDirs = report.LargestDirs[:util.Min(len(report.LargestDirs), EFFICIENCY_TOP_ENTRIES)]
+	return report
+}
+
+func sortPathSizes(sizes []iiapi.PathSize) {
+	sort.Slice(sizes, func(i, j int) bool {
+		if sizes[i].Size != sizes[j].Size {
+			return sizes[i].Size > sizes[j].Size
+		}
+		return sizes[i].Path < sizes[j].Path
+	})
+}
@@ -0,0 +1,67 @@
+package inspector
+
+import (
+	"archive/tar"
+	"bytes"
+	"testing"
+)
+
+func TestEfficiencyAnalyzer(t *testing.T) {
+	layers := [][]tarEntry{
+		{
+			{name: "etc/", typeflag: tar.TypeDir},
+			{name: "etc/config", typeflag: tar.TypeReg, content: "0123456789"},
+			{name: "tmp/", typeflag: tar.TypeDir},
+			{name: "tmp/build.tar", typeflag: tar.TypeReg, content: "01234567890123456789"},
+			{name: "usr/bin/a", typeflag: tar.TypeReg, content: "same"},
+		},
+		{
+			{name: "etc/config", typeflag: tar.TypeReg, content: "01234"},
+			{name: "tmp/.wh.build.tar", typeflag: tar.TypeReg},
+			{name: "usr/bin/b", typeflag: tar.TypeReg, content: "same"},
+		},
+	}
+
+	analyzer := newEfficiencyAnalyzer()
+	for idx, entries := range layers {
+		if err := analyzer.addLayer(tar.NewReader(bytes.NewReader(mkTar(t, entries))), idx); err != nil {
+			t.Fatalf("Unable to analyze layer %d: %v", idx, err)
+		}
+	}
+	report := analyzer.report()
+
+	if len(report.LayerBytes) != 2 || report.LayerBytes[0] != 34 || report.LayerBytes[1] != 9 {
+		t.Errorf("Unexpected bytes per layer %v", report.LayerBytes)
+	}
+	if report.TotalBytes != 43 {
+		t.Errorf("Expected 43 total bytes but got %d", report.TotalBytes)
+	}
+	// 10 overwritten + 20 deleted + 4 duplicated
+	if report.WastedBytes != 34 {
+		t.Errorf("Expected 34 wasted bytes but got %d", report.WastedBytes)
+	}
+	if report.Score != float64(43-34)/43 {
+		t.Errorf("Unexpected score %f", report.Score)
+	}
+	if report.WastedFileCount != 2 || report.WastedFiles[0].Path != "/tmp/build.tar" ||
+		!report.WastedFiles[0].Deleted || report.WastedFiles[1].Occurrences != 2 {
+		t.Errorf("Unexpected wasted files %+v", report.WastedFiles)
+	}
+	if report.DuplicateCount != 1 || len(report.Duplicates[0].Paths) != 2 {
+		t.Errorf("Unexpected duplicates %+v", report.Duplicates)
+	}
+	if report.LargestFiles[0].Path != "/etc/config" || report.LargestFiles[1].Path != "/usr/bin/a" {
+		t.Errorf("Unexpected largest files %+v", report.LargestFiles)
+	}
+	if report.LargestDirs[0].Path != "/" || report.LargestDirs[0].Size != 13 ||
+		report.LargestDirs[1].Path != "/usr" {
+		t.Errorf("Unexpected largest directories %+v", report.LargestDirs)
+	}
+}
+
+func TestEfficiencyAnalyzerEmpty(t *testing.T) {
+	report := newEfficiencyAnalyzer().report()
+	if report.Score != 1 || report.TotalBytes != 0 {
+		t.Errorf("An empty image should be perfectly efficient: %+v", report)
+	}
+}
@@ -1,27 +1,35 @@
 package inspector
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
-	"math"
-	"math/big"
 	"os"
 	"path"
 	"strings"
 	"time"
 
 	"archive/tar"
-	"crypto/rand"
 
 	docker "github.com/fsouza/go-dockerclient"
+	"github.com/openshift/image-inspector/pkg/kube"
+	"github.com/openshift/image-inspector/pkg/metrics"
 	"github.com/openshift/image-inspector/pkg/openscap"
+	"github.com/openshift/image-inspector/pkg/output"
+	"github.com/openshift/image-inspector/pkg/progress"
+	"github.com/openshift/image-inspector/pkg/publish"
+	"github.com/openshift/image-inspector/pkg/registry"
+	iiruntime "github.com/openshift/image-inspector/pkg/runtime"
+	"github.com/openshift/image-inspector/pkg/sbom"
+	"github.com/openshift/image-inspector/pkg/store"
 
 	iicmd "github.com/openshift/image-inspector/pkg/cmd"
 
 	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/auth"
 	apiserver "github.com/openshift/image-inspector/pkg/imageserver"
 )
 
@@ -35,9 +43,19 @@ const (
 	METADATA_URL_PATH        = API_URL_PREFIX + "/" + VERSION_TAG + "/metadata"
 	OPENSCAP_URL_PATH        = API_URL_PREFIX + "/" + VERSION_TAG + "/openscap"
 	OPENSCAP_REPORT_URL_PATH = API_URL_PREFIX + "/" + VERSION_TAG + "/openscap-report"
+	LAYERS_URL_PATH          = API_URL_PREFIX + "/" + VERSION_TAG + "/layers"
+	EFFICIENCY_URL_PATH      = API_URL_PREFIX + "/" + VERSION_TAG + "/efficiency"
+	SARIF_URL_PATH           = API_URL_PREFIX + "/" + VERSION_TAG + "/sarif"
+	JUNIT_URL_PATH           = API_URL_PREFIX + "/" + VERSION_TAG + "/junit"
+	ATTESTATION_URL_PATH     = API_URL_PREFIX + "/" + VERSION_TAG + "/attestation"
+	SEARCH_URL_PATH          = API_URL_PREFIX + "/" + VERSION_TAG + "/search"
+	STATUS_URL_PATH          = API_URL_PREFIX + "/" + VERSION_TAG + "/status"
+	EVENTS_URL_PATH          = STATUS_URL_PATH + "/events"
+	METRICS_URL_PATH         = "/metrics"
 	CHROOT_SERVE_PATH        = "/"
 	OSCAP_CVE_DIR            = "/tmp"
 	PULL_LOG_INTERVAL_SEC    = 10
+	KUBE_API_TIMEOUT         = 30 * time.Second
 )
 
 var osMkdir = os.Mkdir
@@ -47,14 +65,36 @@ var ioutilTempDir = ioutil.TempDir
 type ImageInspector interface {
 	// Inspect inspects and serves the image based on the ImageInspectorOptions.
 	Inspect() error
+	// Report returns the outcome of the inspection after Inspect returned.
+	Report() *iiapi.InspectorReport
 }
 
 // defaultImageInspector is the default implementation of ImageInspector.
 type defaultImageInspector struct {
 	opts iicmd.ImageInspectorOptions
 	meta iiapi.InspectorMetadata
+	// layerFiles records the layer that introduced each file when the image
+	// is extracted layer by layer.
+	layerFiles iiapi.LayerFiles
+	// findings are the structured results of the scan.
+	findings []iiapi.Finding
+	// manifest is the manifest the image is pulled by, when resolved.
+	manifest *registry.Manifest
+	// registryAuth is the authentication the registry of the image accepted.
+	registryAuth *docker.AuthConfiguration
+	// sbom is the software bill of materials of the image, if generated.
+	sbom []byte
+	// attestation is the signed attestation of the inspection, if any.
+	attestation *iiapi.Envelope
 	// an optional image server that will server content for inspection.
 	imageServer apiserver.ImageServer
+	// tempDirs are the temporary directories created by the inspector.
+	tempDirs []*TempDir
+	// createdDirs are the directories given by the options that didn't
+	// exist and were created by the inspector.
+	createdDirs []string
+	// progress tracks the progress of the inspection.
+	progress *progress.Tracker
 }
 
 // NewInspectorMetadata returns a new InspectorMetadata out of *docker.Image
@@ -70,74 +110,394 @@ func NewInspectorMetadata(imageMetadata *docker.Image) iiapi.InspectorMetadata {
 	}
 }
 
-// NewDefaultImageInspector provides a new default inspector.
+// NewDefaultImageInspector provides a new default inspector, inspecting
+// every platform of the image in turn when asked to.
 func NewDefaultImageInspector(opts iicmd.ImageInspectorOptions) ImageInspector {
-	inspector := &defaultImageInspector{
-		opts: opts,
-		meta: NewInspectorMetadata(&docker.Image{}),
-	}
-
-	// if serving then set up an image server
-	if len(opts.Serve) > 0 {
-		imageServerOpts := apiserver.ImageServerOptions{
-			ServePath:         opts.Serve,
-			HealthzURL:        HEALTHZ_URL_PATH,
-			APIURL:            API_URL_PREFIX,
-			APIVersions:       iiapi.APIVersions{Versions: []string{VERSION_TAG}},
-			MetadataURL:       METADATA_URL_PATH,
-			ContentURL:        CONTENT_URL_PREFIX,
-			ImageServeURL:     opts.DstPath,
-			ScanType:          opts.ScanType,
-			ScanReportURL:     OPENSCAP_URL_PATH,
-			HTMLScanReport:    opts.OpenScapHTML,
-			HTMLScanReportURL: OPENSCAP_REPORT_URL_PATH,
-		}
-		inspector.imageServer = apiserver.NewWebdavImageServer(imageServerOpts, opts.Chroot)
-	}
-	return inspector
+	if opts.AllPlatforms {
+		return &platformsInspector{opts: opts}
+	}
+	return &defaultImageInspector{
+		opts:     opts,
+		meta:     NewInspectorMetadata(&docker.Image{}),
+		progress: progress.NewTracker(opts.Image),
+	}
+}
+
+// NewAuthFilter returns the authentication filter configured by opts, nil
+// when no authentication is configured.
+func NewAuthFilter(opts iicmd.ImageInspectorOptions) (*auth.Filter, error) {
+	config := auth.Config{
+		TokenFile:    opts.AuthTokenFile,
+		HtpasswdFile: opts.AuthHtpasswdFile,
+		ContentUsers: opts.AuthContentUsers.Values,
+		// the certificates are verified by the server when a client CA is given
+		ClientCertificates: len(opts.TLSClientCA) > 0,
+	}
+	if opts.AuthTokenReview {
+		reviewer, err := auth.NewInClusterTokenReviewer([]string{opts.AuthTokenAudience})
+		if err != nil {
+			return nil, err
+		}
+		config.TokenReviewer = reviewer
+	}
+	return auth.NewFilter(config)
+}
+
+// newImageServer sets up the image server that will serve the content for
+// inspection.
+func (i *defaultImageInspector) newImageServer() (apiserver.ImageServer, error) {
+	authFilter, err := NewAuthFilter(i.opts)
+	if err != nil {
+		return nil, err
+	}
+	imageServerOpts := apiserver.ImageServerOptions{
+		ServePath:          i.opts.Serve,
+		HealthzURL:         HEALTHZ_URL_PATH,
+		APIURL:             API_URL_PREFIX,
+		APIVersions:        iiapi.APIVersions{Versions: []string{VERSION_TAG}},
+		MetadataURL:        METADATA_URL_PATH,
+		ContentURL:         CONTENT_URL_PREFIX,
+		ImageServeURL:      i.opts.DstPath,
+		ScanType:           i.opts.ScanType,
+		ScanReportURL:      OPENSCAP_URL_PATH,
+		HTMLScanReport:     i.opts.OpenScapHTML,
+		HTMLScanReportURL:  OPENSCAP_REPORT_URL_PATH,
+		LayersURL:          LAYERS_URL_PATH,
+		EfficiencyURL:      EFFICIENCY_URL_PATH,
+		SARIFURL:           SARIF_URL_PATH,
+		JUnitURL:           JUNIT_URL_PATH,
+		AttestationURL:     ATTESTATION_URL_PATH,
+		StatusURL:          STATUS_URL_PATH,
+		EventsURL:          EVENTS_URL_PATH,
+		SearchURL:          SEARCH_URL_PATH,
+		MetricsURL:         METRICS_URL_PATH,
+		Auth:               authFilter,
+		TLSCertFile:        i.opts.TLSCert,
+		TLSKeyFile:         i.opts.TLSKey,
+		TLSClientCAFile:    i.opts.TLSClientCA,
+		ServeTimeout:       i.opts.ServeTimeout,
+		IdleTimeout:        i.opts.IdleTimeout,
+		ExitAfterDownloads: i.opts.ExitAfterDownloads,
+	}
+	return apiserver.NewWebdavImageServer(imageServerOpts, i.opts.Chroot), nil
 }
 
 // Inspect inspects and serves the image based on the ImageInspectorOptions.
+// The temporary directories are removed once done, also on error.
 func (i *defaultImageInspector) Inspect() error {
-	client, err := docker.NewClient(i.opts.URI)
+	defer i.releaseTempDirs()
+	err := i.inspect()
 	if err != nil {
-		return fmt.Errorf("Unable to connect to docker daemon: %v\n", err)
+		i.progress.Fail(err)
+		if i.imageServer != nil {
+			if serr := i.imageServer.ServeFailure(); serr != nil {
+				log.Printf("Unable to serve the status of the failed inspection: %v", serr)
+			}
+		}
+	} else {
+		i.progress.SetStage(iiapi.ProgressReady)
 	}
+	return err
+}
 
-	if err = i.pullImage(client); err != nil {
-		return err
+func (i *defaultImageInspector) inspect() error {
+	started := time.Now()
+	// if serving then start an image server serving the progress, failing
+	// early on a bad setup
+	if len(i.opts.Serve) > 0 && i.imageServer == nil {
+		imageServer, err := i.newImageServer()
+		if err != nil {
+			return err
+		}
+		if err = imageServer.Start(i.progress); err != nil {
+			return err
+		}
+		i.imageServer = imageServer
 	}
 
-	randomName, err := generateRandomName()
+	rt, err := NewRuntime(i.opts)
 	if err != nil {
 		return err
 	}
 
-	imageMetadata, err := i.createAndExtractImage(client, randomName)
+	reference := i.opts.Image
+	if len(i.opts.Platform) > 0 {
+		if err = i.selectPlatform(); err != nil {
+			return err
+		}
+	}
+
+	stageStart := time.Now()
+	// the image of a container is already there
+	if len(i.opts.Container) == 0 {
+		if err = i.resolveImage(reference); err != nil {
+			return err
+		}
+		if len(i.opts.SignaturePolicy) > 0 {
+			if err = i.verifySignatures(); err != nil {
+				return err
+			}
+		}
+		err = i.pullImage(rt)
+		metrics.ObserveStage(metrics.StagePull, stageStart, err)
+		if err != nil {
+			return err
+		}
+		if i.meta.Provenance != nil {
+			i.meta.Provenance.PullTime = time.Now()
+		}
+	}
+
+	var imageMetadata *docker.Image
+	i.progress.SetStage(iiapi.ProgressExtracting)
+	stageStart = time.Now()
+	switch {
+	case len(i.opts.Container) > 0:
+		imageMetadata, err = i.extractContainer(rt)
+	case i.opts.ExtractLayers:
+		imageMetadata, err = i.extractImageLayers(rt)
+	default:
+		imageMetadata, err = i.createAndExtractImage(rt)
+	}
+	metrics.ObserveStage(metrics.StageExtract, stageStart, err)
 	if err != nil {
 		return err
 	}
+	if err = i.checkPlatform(imageMetadata); err != nil {
+		return err
+	}
+	if err = i.verifyDigest(imageMetadata); err != nil {
+		return err
+	}
 	i.meta.Image = *imageMetadata
 
+	if i.sbom, err = sbom.Generate(i.opts.DstPath, i.opts.Image, time.Now()); err != nil {
+		log.Printf("WARNING: Unable to generate the SBOM of %s: %v", i.opts.Image, err)
+	}
+
 	var scanReport []byte
 	var htmlScanReport []byte
 	if i.opts.ScanType == "openscap" {
-		if i.opts.ScanResultsDir, err = createOutputDir(i.opts.ScanResultsDir, "image-inspector-scan-results-"); err != nil {
+		if i.opts.ScanResultsDir, err = i.createOutputDir(i.opts.ScanResultsDir, TEMP_DIR_PREFIX+"scan-results-"); err != nil {
 			return err
 		}
 		scanner := openscap.NewDefaultScanner(OSCAP_CVE_DIR, i.opts.ScanResultsDir, i.opts.CVEUrlPath, i.opts.OpenScapHTML)
+		i.progress.SetStage(iiapi.ProgressScanning)
+		stageStart = time.Now()
 		scanReport, htmlScanReport, err = i.scanImage(scanner)
+		metrics.ObserveStage(metrics.StageScan, stageStart, err)
 		if err != nil {
 			i.meta.OpenSCAP.SetError(err)
 			log.Printf("Unable to scan image: %v", err)
 		} else {
 			i.meta.OpenSCAP.Status = iiapi.StatusSuccess
+			if i.findings, err = openscap.ParseResults(bytes.NewReader(scanReport)); err != nil {
+				log.Printf("WARNING: %v", err)
+			}
 		}
 	}
+	i.attributeFindings()
+	metrics.SetFindings(i.findings)
+
+	if len(i.opts.AttestationKey) > 0 {
+		if err = i.attest(); err != nil {
+			return err
+		}
+	}
+
+	if len(i.opts.StorePath) > 0 {
+		if err = i.storeResult(started); err != nil {
+			return err
+		}
+	}
+
+	if len(i.opts.Publish) > 0 {
+		i.publishSummary()
+	}
+
+	if len(i.opts.Output) > 0 {
+		if err = writeReport(i.opts, i.Report()); err != nil {
+			return err
+		}
+	}
+
+	if i.opts.MinEfficiency > 0 && i.meta.Efficiency.Score < i.opts.MinEfficiency {
+		return fmt.Errorf("Image efficiency score %.4f is lower than %.4f\n",
+			i.meta.Efficiency.Score, i.opts.MinEfficiency)
+	}
 
 	if i.imageServer != nil {
-		return i.imageServer.ServeImage(&i.meta,
-			scanReport, htmlScanReport)
+		return i.serveAndCleanUp(scanReport, htmlScanReport)
+	}
+	return nil
+}
+
+// serveAndCleanUp serves the image and removes the directories created by
+// the inspector, holding the extracted image and the scan results, once the
+// server shuts down, unless they are kept. The directories that existed
+// before the inspection are always kept.
+func (i *defaultImageInspector) serveAndCleanUp(scanReport, htmlScanReport []byte) error {
+	created := append([]string{}, i.createdDirs...)
+	for _, dir := range i.tempDirs {
+		created = append(created, dir.Path)
+	}
+	// the paths are opened before serving since the server may chroot
+	paths := []string{}
+	if !i.opts.Keep {
+		paths = append(paths, created...)
+	}
+	for _, dir := range i.tempDirs {
+		paths = append(paths, dir.LockPath())
+	}
+	remover, err := newDirRemover(paths...)
+	if err != nil {
+		return err
+	}
+	defer remover.Close()
+
+	err = i.imageServer.ServeImage(i.Report(), i.layerFiles, scanReport, htmlScanReport)
+	if len(created) > 0 {
+		if i.opts.Keep {
+			log.Printf("Keeping %s", strings.Join(created, " "))
+		} else {
+			log.Printf("Removing %s", strings.Join(created, " "))
+		}
+	}
+	for _, dir := range []string{i.opts.DstPath, i.opts.ScanResultsDir} {
+		if len(dir) > 0 && !containsString(created, dir) {
+			log.Printf("Keeping %s, it existed before the inspection", dir)
+		}
+	}
+	if removeErr := remover.RemoveAll(); removeErr != nil {
+		log.Printf("WARNING: %v", removeErr)
+	}
+	for _, dir := range i.tempDirs {
+		dir.Unlock()
+	}
+	i.tempDirs = nil
+	return err
+}
+
+// createOutputDir creates dirName or, when it's empty, a temporary directory
+// removed when the inspection is done. dirName is recorded when it didn't
+// exist, an existing directory is never removed.
+func (i *defaultImageInspector) createOutputDir(dirName string, tempName string) (string, error) {
+	if len(dirName) > 0 {
+		created, err := createDir(dirName)
+		if err != nil {
+			return "", err
+		}
+		if created && !containsString(i.createdDirs, dirName) {
+			i.createdDirs = append(i.createdDirs, dirName)
+		}
+		return dirName, nil
+	}
+	dir, err := NewTempDir(TEMP_DIR_ROOT, tempName)
+	if err != nil {
+		return "", err
+	}
+	i.tempDirs = append(i.tempDirs, dir)
+	return dir.Path, nil
+}
+
+// releaseTempDirs removes the temporary directories, unless they are kept.
+func (i *defaultImageInspector) releaseTempDirs() {
+	for _, dir := range i.tempDirs {
+		if i.opts.Keep {
+			log.Printf("Keeping %s", dir.Path)
+		}
+		if err := dir.Release(i.opts.Keep); err != nil {
+			log.Printf("WARNING: %v", err)
+		}
+	}
+	i.tempDirs = nil
+}
+
+// attributeFindings records the layer that introduced the file of each finding.
+func (i *defaultImageInspector) attributeFindings() {
+	if i.layerFiles == nil {
+		return
+	}
+	for idx := range i.findings {
+		if len(i.findings[idx].Path) > 0 {
+			i.findings[idx].Layer = i.meta.LayerOf(i.layerFiles, i.findings[idx].Path)
+		}
+	}
+}
+
+// Report returns the complete outcome of the inspection.
+func (i *defaultImageInspector) Report() *iiapi.InspectorReport {
+	report := &iiapi.InspectorReport{
+		Image:       i.opts.Image,
+		Metadata:    &i.meta,
+		Findings:    i.findings,
+		SBOM:        i.sbom,
+		Attestation: i.attestation,
+	}
+	if report.Findings == nil {
+		report.Findings = []iiapi.Finding{}
+	}
+	return report
+}
+
+// storeResult records the outcome of the inspection in the results store.
+func (i *defaultImageInspector) storeResult(started time.Time) error {
+	resultStore, err := store.NewDiskStore(i.opts.StorePath)
+	if err != nil {
+		return err
+	}
+	scanTypes := []string{}
+	if len(i.opts.ScanType) > 0 {
+		scanTypes = append(scanTypes, i.opts.ScanType)
+	}
+	result := store.NewScanResult(i.Report(), scanTypes, started, time.Now())
+	if err = resultStore.Put(result); err != nil {
+		return err
+	}
+	log.Printf("Recorded the scan result %s of %s", result.ID, result.ImageDigest)
+	return nil
+}
+
+// publishSummary publishes the summary of the inspection to the Kubernetes
+// API of the cluster the pod runs in. Failing to publish doesn't fail the
+// inspection.
+func (i *defaultImageInspector) publishSummary() {
+	resultsURL := ""
+	if len(i.opts.PublishURL) > 0 {
+		resultsURL = strings.TrimSuffix(i.opts.PublishURL, "/") + METADATA_URL_PATH
+		if len(i.opts.ScanType) > 0 {
+			resultsURL = strings.TrimSuffix(i.opts.PublishURL, "/") + OPENSCAP_URL_PATH
+		}
+	}
+	summary := publish.NewScanSummary(i.Report(), time.Now(), resultsURL)
+	client, err := kube.NewInClusterClient(KUBE_API_TIMEOUT)
+	if err == nil {
+		var publisher publish.Publisher
+		if publisher, err = publish.NewPublisher(i.opts.Publish, client, i.opts.PublishNamespace); err == nil {
+			err = publisher.Publish(summary, i.opts.PublishPods.Values)
+		}
+	}
+	if err != nil {
+		log.Printf("WARNING: Unable to publish the summary of the inspection: %v", err)
+		return
+	}
+	log.Printf("Published the summary of the inspection of %s", i.opts.Image)
+}
+
+// writeReport writes report in the output format of opts to the output file
+// of opts or to the standard output.
+func writeReport(opts iicmd.ImageInspectorOptions, report *iiapi.InspectorReport) error {
+	var w io.Writer = os.Stdout
+	if len(opts.OutputFile) > 0 {
+		file, err := os.Create(opts.OutputFile)
+		if err != nil {
+			return fmt.Errorf("Unable to create output file: %v\n", err)
+		}
+		defer file.Close()
+		w = file
+	}
+	if err := output.Render(w, opts.Output, report); err != nil {
+		return fmt.Errorf("Unable to write the %s report: %v\n", opts.Output, err)
 	}
 	return nil
 }
@@ -157,6 +517,7 @@ func aggregateBytesAndReport(bytesChan chan int) {
 				return
 			}
 			bytesDownloaded += bytes
+			metrics.DownloadedBytes.Add(float64(bytes))
 		case <-ticker.C:
 			log.Printf("Downloading Image (%dKb downloaded)", bytesDownloaded/1024)
 		}
@@ -165,8 +526,9 @@ func aggregateBytesAndReport(bytesChan chan int) {
 
 // decodeDockerPullMessages will parse the docker pull messages received
 // from reader and will push the difference of bytes downloaded to
-// bytesChan. After reader is closed it will close bytesChan and exit.
-func decodeDockerPullMessages(bytesChan chan int, reader io.Reader) {
+// bytesChan, recording the bytes downloaded of each layer in tracker.
+// After reader is closed it will close bytesChan and exit.
+func decodeDockerPullMessages(bytesChan chan int, reader io.Reader, tracker *progress.Tracker) {
 	type progressDetailType struct {
 		Current, Total int
 	}
@@ -193,15 +555,26 @@ func decodeDockerPullMessages(bytesChan chan int, reader io.Reader) {
 				last = 0
 			}
 			layersBytesDownloaded[v.Id] = bytes
+			tracker.SetLayerBytes(v.Id, int64(bytes), int64(v.ProgressDetail.Total))
 			bytesChan <- (bytes - last)
 		}
 	}
 }
 
-// pullImage pulls the inspected image using the given client.
+// NewRuntime returns the container runtime of opts, reached at the docker
+// socket or at the runtime endpoint.
+func NewRuntime(opts iicmd.ImageInspectorOptions) (iiruntime.Runtime, error) {
+	endpoint := opts.RuntimeEndpoint
+	if opts.Runtime == iiruntime.RUNTIME_DOCKER {
+		endpoint = opts.URI
+	}
+	return iiruntime.NewRuntime(opts.Runtime, endpoint)
+}
+
+// pullImage pulls the inspected image using the given runtime.
 // It will try to use all the given authentication methods and will fail
 // only if all of them failed.
-func (i *defaultImageInspector) pullImage(client *docker.Client) error {
+func (i *defaultImageInspector) pullImage(rt iiruntime.Runtime) error {
 	log.Printf("Pulling image %s", i.opts.Image)
 
 	var imagePullAuths *docker.AuthConfigurations
@@ -214,64 +587,34 @@ func (i *defaultImageInspector) pullImage(client *docker.Client) error {
 	// handle closing the reader/writer in the method that creates them
 	defer writer.Close()
 	defer reader.Close()
-	imagePullOption := docker.PullImageOptions{
-		Repository:    i.opts.Image,
-		OutputStream:  writer,
-		RawJSONStream: true,
-	}
 
 	bytesChan := make(chan int)
 	go aggregateBytesAndReport(bytesChan)
-	go decodeDockerPullMessages(bytesChan, reader)
+	go decodeDockerPullMessages(bytesChan, reader, i.progress)
 
 	// Try all the possible auth's from the config file
 	var authErr error
 	for name, auth := range imagePullAuths.Configs {
-		if authErr = client.PullImage(imagePullOption, auth); authErr == nil {
+		if authErr = rt.PullImage(i.opts.Image, auth, writer); authErr == nil {
 			return nil
 		}
 		log.Printf("Authentication with %s failed: %v", name, authErr)
 	}
-	return fmt.Errorf("Unable to pull docker image: %v\n", authErr)
+	return fmt.Errorf("Unable to pull %s image: %v\n", rt.Name(), authErr)
 }
 
-// createAndExtractImage creates a docker container based on the option's image with containerName.
-// It will then insepct the container and image and then attempt to extract the image to
-// option's destination path.  If the destination path is empty it will write to a temp directory
-// and update the option's destination path with a /var/tmp directory.  /var/tmp is used to
-// try and ensure it is a non-in-memory tmpfs.
-func (i *defaultImageInspector) createAndExtractImage(client *docker.Client, containerName string) (*docker.Image, error) {
-	container, err := client.CreateContainer(docker.CreateContainerOptions{
-		Name: containerName,
-		Config: &docker.Config{
-			Image: i.opts.Image,
-			// For security purpose we don't define any entrypoint and command
-			Entrypoint: []string{""},
-			Cmd:        []string{""},
-		},
-	})
-	if err != nil {
-		return nil, fmt.Errorf("Unable to create docker container: %v\n", err)
-	}
-
-	// delete the container when we are done extracting it
-	defer func() {
-		client.RemoveContainer(docker.RemoveContainerOptions{
-			ID: container.ID,
-		})
-	}()
-
-	containerMetadata, err := client.InspectContainer(container.ID)
+// createAndExtractImage inspects the option's image and then has the runtime export its
+// filesystem, through a container created for the purpose, to option's destination path.
+// If the destination path is empty it will write to a temp directory and update the
+// option's destination path with a /var/tmp directory.  /var/tmp is used to try and
+// ensure it is a non-in-memory tmpfs.
+func (i *defaultImageInspector) createAndExtractImage(rt iiruntime.Runtime) (*docker.Image, error) {
+	imageMetadata, err := rt.InspectImage(i.opts.Image)
 	if err != nil {
-		return nil, fmt.Errorf("Unable to get docker container information: %v\n", err)
+		return imageMetadata, fmt.Errorf("Unable to get %s image information: %v\n", rt.Name(), err)
 	}
 
-	imageMetadata, err := client.InspectImage(containerMetadata.Image)
-	if err != nil {
-		return imageMetadata, fmt.Errorf("Unable to get docker image information: %v\n", err)
-	}
-
-	if i.opts.DstPath, err = createOutputDir(i.opts.DstPath, "image-inspector-"); err != nil {
+	if i.opts.DstPath, err = i.createOutputDir(i.opts.DstPath, TEMP_DIR_PREFIX); err != nil {
 		return imageMetadata, err
 	}
 
@@ -286,19 +629,16 @@ func (i *defaultImageInspector) createAndExtractImage(client *docker.Client, con
 	// the reader to read.
 	errorChannel := make(chan error)
 	go func() {
-		errorChannel <- client.DownloadFromContainer(
-			container.ID,
-			docker.DownloadFromContainerOptions{
-				OutputStream: writer,
-				Path:         "/",
-			})
+		err := rt.ExportRootfs(imageMetadata.ID, writer)
+		writer.CloseWithError(err)
+		errorChannel <- err
 	}()
 
 	// block on handling the reads here so we ensure both the write and the reader are finished
 	// (read waits until an EOF or error occurs).
-	handleTarStream(reader, i.opts.DstPath)
+	handleTarStream(reader, i.opts.DstPath, i.progress)
 
-	// capture any error from the copy, ensures both the handleTarStream and DownloadFromContainer
+	// capture any error from the copy, ensures both the handleTarStream and ExportRootfs
 	// are done.
 	err = <-errorChannel
 	if err != nil {
@@ -308,10 +648,10 @@ func (i *defaultImageInspector) createAndExtractImage(client *docker.Client, con
 	return imageMetadata, nil
 }
 
-func handleTarStream(reader io.ReadCloser, destination string) {
+func handleTarStream(reader io.ReadCloser, destination string, tracker *progress.Tracker) {
 	tr := tar.NewReader(reader)
 	if tr != nil {
-		err := processTarStream(tr, destination)
+		err := processTarStream(tr, destination, tracker)
 		if err != nil {
 			log.Print(err)
 		}
@@ -320,7 +660,7 @@ func handleTarStream(reader io.ReadCloser, destination string) {
 	}
 }
 
-func processTarStream(tr *tar.Reader, destination string) error {
+func processTarStream(tr *tar.Reader, destination string, tracker *progress.Tracker) error {
 	for {
 		hdr, err := tr.Next()
 		if err != nil {
@@ -330,58 +670,62 @@ func processTarStream(tr *tar.Reader, destination string) error {
 			return fmt.Errorf("Unable to extract container: %v\n", err)
 		}
 
-		hdrInfo := hdr.FileInfo()
-
 		dstpath := path.Join(destination, strings.TrimPrefix(hdr.Name, DOCKER_TAR_PREFIX))
-		// Overriding permissions to allow writing content
-		mode := hdrInfo.Mode() | OWNER_PERM_RW
-
-		switch hdr.Typeflag {
-		case tar.TypeDir:
-			if err := os.Mkdir(dstpath, mode); err != nil {
-				if !os.IsExist(err) {
-					return fmt.Errorf("Unable to create directory: %v", err)
-				}
-				err = os.Chmod(dstpath, mode)
-				if err != nil {
-					return fmt.Errorf("Unable to update directory mode: %v", err)
-				}
+		linkpath := path.Join(destination, strings.TrimPrefix(hdr.Linkname, DOCKER_TAR_PREFIX))
+		if err := extractTarEntry(tr, hdr, dstpath, linkpath); err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeDir {
+			tracker.FileExtracted()
+		}
+	}
+}
+
+// extractTarEntry writes the tar entry described by hdr to dstpath. linkpath is
+// the already translated destination of a hard link target.
+func extractTarEntry(tr *tar.Reader, hdr *tar.Header, dstpath, linkpath string) error {
+	hdrInfo := hdr.FileInfo()
+
+	// Overriding permissions to allow writing content
+	mode := hdrInfo.Mode() | OWNER_PERM_RW
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		if err := os.Mkdir(dstpath, mode); err != nil {
+			if !os.IsExist(err) {
+				return fmt.Errorf("Unable to create directory: %v", err)
 			}
-		case tar.TypeReg, tar.TypeRegA:
-			file, err := os.OpenFile(dstpath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+			err = os.Chmod(dstpath, mode)
 			if err != nil {
-				return fmt.Errorf("Unable to create file: %v", err)
-			}
-			if _, err := io.Copy(file, tr); err != nil {
-				file.Close()
-				return fmt.Errorf("Unable to write into file: %v", err)
+				return fmt.Errorf("Unable to update directory mode: %v", err)
 			}
+		}
+	case tar.TypeReg, tar.TypeRegA:
+		file, err := os.OpenFile(dstpath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+		if err != nil {
+			return fmt.Errorf("Unable to create file: %v", err)
+		}
+		if _, err := io.Copy(file, tr); err != nil {
 			file.Close()
-		case tar.TypeSymlink:
-			if err := os.Symlink(hdr.Linkname, dstpath); err != nil {
-				return fmt.Errorf("Unable to create symlink: %v\n", err)
-			}
-		case tar.TypeLink:
-			target := path.Join(destination, strings.TrimPrefix(hdr.Linkname, DOCKER_TAR_PREFIX))
-			if err := os.Link(target, dstpath); err != nil {
-				return fmt.Errorf("Unable to create link: %v\n", err)
-			}
-		default:
-			// For now we're skipping anything else. Special device files and
-			// symlinks are not needed or anyway probably incorrect.
+			return fmt.Errorf("Unable to write into file: %v", err)
 		}
-
-		// maintaining access and modification time in best effort fashion
-		os.Chtimes(dstpath, hdr.AccessTime, hdr.ModTime)
+		file.Close()
+	case tar.TypeSymlink:
+		if err := os.Symlink(hdr.Linkname, dstpath); err != nil {
+			return fmt.Errorf("Unable to create symlink: %v\n", err)
+		}
+	case tar.TypeLink:
+		if err := os.Link(linkpath, dstpath); err != nil {
+			return fmt.Errorf("Unable to create link: %v\n", err)
+		}
+	default:
+		// For now we're skipping anything else. Special device files and
+		// symlinks are not needed or anyway probably incorrect.
 	}
-}
 
-func generateRandomName() (string, error) {
-	n, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
-	if err != nil {
-		return "", fmt.Errorf("Unable to generate random container name: %v\n", err)
-	}
-	return fmt.Sprintf("image-inspector-%016x", n), nil
+	// maintaining access and modification time in best effort fashion
+	os.Chtimes(dstpath, hdr.AccessTime, hdr.ModTime)
+	return nil
 }
 
 func appendDockerCfgConfigs(dockercfg string, cfgs *docker.AuthConfigurations) error {
@@ -449,13 +793,30 @@ func (i *defaultImageInspector) scanImage(s openscap.Scanner) ([]byte, []byte, e
 	return scanReport, htmlScanReport, nil
 }
 
+// createDir creates dirName, returning false when it already existed.
+func createDir(dirName string) (bool, error) {
+	if err := osMkdir(dirName, 0755); err != nil {
+		if os.IsExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("Unable to create destination path: %v\n", err)
+	}
+	return true, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func createOutputDir(dirName string, tempName string) (string, error) {
 	if len(dirName) > 0 {
-		err := osMkdir(dirName, 0755)
-		if err != nil {
-			if !os.IsExist(err) {
-				return "", fmt.Errorf("Unable to create destination path: %v\n", err)
-			}
+		if _, err := createDir(dirName); err != nil {
+			return "", err
 		}
 	} else {
 		// forcing to use /var/tmp because often it's not an in-memory tmpfs
@@ -143,7 +143,7 @@ func TestGetAuthConfigs(t *testing.T) {
 	}
 
 	for k, v := range tests {
-		ii := &defaultImageInspector{*v.opts, iiapi.InspectorMetadata{}, nil}
+		ii := &defaultImageInspector{opts: *v.opts, meta: iiapi.InspectorMetadata{}}
 		auths, err := ii.getAuthConfigs()
 		if !v.shouldFail {
 			if err != nil {
@@ -214,3 +214,28 @@ func TestCreateOutputDir(t *testing.T) {
 		}
 	}
 }
+
+func TestRepoDigest(t *testing.T) {
+	repoDigests := []string{
+		"docker.io/library/fedora@sha256:1111",
+		"registry.example.com:5000/team/app@sha256:2222",
+		"nginx@sha256:3333",
+	}
+	for k, v := range map[string]struct {
+		image    string
+		expected string
+	}{
+		"short name":         {image: "fedora:latest", expected: "docker.io/library/fedora@sha256:1111"},
+		"registry with port": {image: "registry.example.com:5000/team/app:1.0", expected: "registry.example.com:5000/team/app@sha256:2222"},
+		"short repo digest":  {image: "docker.io/library/nginx:1.25", expected: "nginx@sha256:3333"},
+		"legacy hub":         {image: "index.docker.io/library/nginx", expected: "nginx@sha256:3333"},
+		"other repository":   {image: "centos:7", expected: ""},
+		"suffix of a name":   {image: "app:1.0", expected: ""},
+		"other registry":     {image: "registry.example.com/team/app:1.0", expected: ""},
+		"partial name":       {image: "ora:latest", expected: ""},
+	} {
+		if digest := repoDigest(v.image, repoDigests); digest != v.expected {
+			t.Errorf("%s: expected %s but got %s", k, v.expected, digest)
+		}
+	}
+}
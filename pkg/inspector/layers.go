@@ -0,0 +1,500 @@
+package inspector
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/progress"
+	iiruntime "github.com/openshift/image-inspector/pkg/runtime"
+)
+
+const (
+	SAVE_MANIFEST_FILE  = "manifest.json"
+	SAVE_LAYER_FILE     = "layer.tar"
+	SAVE_LEGACY_JSON    = "json"
+	WHITEOUT_PREFIX     = ".wh."
+	WHITEOUT_OPAQUE_DIR = WHITEOUT_PREFIX + WHITEOUT_PREFIX + ".opq"
+	// SAVE_BLOBS_DIR holds the layers and the configuration of OCI archives
+	SAVE_BLOBS_DIR = "blobs/"
+)
+
+// saveManifestEntry is an entry of the manifest.json file found in the
+// tarball produced by docker save.
+type saveManifestEntry struct {
+	Config   string
+	RepoTags []string
+	Layers   []string
+}
+
+// imageConfigHistory is a history entry of the image configuration.
+type imageConfigHistory struct {
+	Created    time.Time `json:"created"`
+	CreatedBy  string    `json:"created_by"`
+	EmptyLayer bool      `json:"empty_layer"`
+}
+
+// imageConfig holds the parts of the image configuration we care about.
+type imageConfig struct {
+	History []imageConfigHistory `json:"history"`
+}
+
+// legacyLayerJSON is the per layer json file of pre 1.10 docker save tarballs.
+type legacyLayerJSON struct {
+	ID      string    `json:"id"`
+	Parent  string    `json:"parent"`
+	Created time.Time `json:"created"`
+}
+
+// imageSave is the parsed content of a docker save tarball. The layer tarballs
+// are spooled to disk because the save stream doesn't guarantee any order.
+type imageSave struct {
+	spoolDir string
+	manifest []saveManifestEntry
+	configs  map[string][]byte
+	legacy   map[string]legacyLayerJSON
+	// layer tarball name -> spooled file and digest
+	layerFiles   map[string]string
+	layerDigests map[string]string
+}
+
+// extractImageLayers exports the option's image with docker save and applies
+// its layers one by one to the option's destination path. It records which
+// layer introduced each file and the per-layer size breakdown.
+func (i *defaultImageInspector) extractImageLayers(rt iiruntime.Runtime) (*docker.Image, error) {
+	imageMetadata, err := rt.InspectImage(i.opts.Image)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get %s image information: %v\n", rt.Name(), err)
+	}
+
+	if i.opts.DstPath, err = i.createOutputDir(i.opts.DstPath, TEMP_DIR_PREFIX); err != nil {
+		return imageMetadata, err
+	}
+
+	// the spooled layers are only needed while extracting, never kept
+	spool, err := NewTempDir(TEMP_DIR_ROOT, TEMP_DIR_PREFIX+"layers-")
+	if err != nil {
+		return imageMetadata, err
+	}
+	defer func() {
+		if err := spool.Release(false); err != nil {
+			log.Printf("WARNING: %v", err)
+		}
+	}()
+	spoolDir := spool.Path
+
+	reader, writer := io.Pipe()
+	// handle closing the reader/writer in the method that creates them
+	defer writer.Close()
+	defer reader.Close()
+
+	log.Printf("Extracting image %s layer by layer to %s", i.opts.Image, i.opts.DstPath)
+
+	errorChannel := make(chan error)
+	go func() {
+		err := rt.ExportImage(i.opts.Image, writer)
+		writer.CloseWithError(err)
+		errorChannel <- err
+	}()
+
+	save, readErr := readImageSave(reader, spoolDir)
+	// drain whatever is left so that the export can finish
+	io.Copy(ioutil.Discard, reader)
+	if err = <-errorChannel; err != nil {
+		return imageMetadata, fmt.Errorf("Unable to export image: %v\n", err)
+	}
+	if readErr != nil {
+		return imageMetadata, readErr
+	}
+
+	layers, err := save.orderedLayers(imageMetadata.ID)
+	if err != nil {
+		return imageMetadata, err
+	}
+
+	var history []docker.ImageHistory
+	if len(save.manifest) == 0 {
+		// legacy tarballs have no image configuration, ask the runtime
+		if history, err = rt.ImageHistory(i.opts.Image); err != nil {
+			log.Printf("WARNING: Unable to get the image history: %v", err)
+		}
+	}
+
+	i.meta.Layers = save.layerMetadata(layers, history)
+	i.layerFiles = iiapi.LayerFiles{}
+	spooled := make([]string, len(layers))
+	for idx, name := range layers {
+		spooled[idx] = save.layerFiles[name]
+		if err := applyLayerFile(spooled[idx], i.opts.DstPath, idx,
+			&i.meta.Layers[idx], i.layerFiles, i.progress); err != nil {
+			return imageMetadata, err
+		}
+	}
+
+	if i.meta.Efficiency, err = analyzeLayerEfficiency(spooled); err != nil {
+		return imageMetadata, err
+	}
+	log.Printf("Image efficiency score %.4f (%d of %d bytes wasted)", i.meta.Efficiency.Score,
+		i.meta.Efficiency.WastedBytes, i.meta.Efficiency.TotalBytes)
+
+	return imageMetadata, nil
+}
+
+// readImageSave reads a docker save tarball spooling the layers in spoolDir.
+// The OCI archives with a docker manifest.json, as exported by podman,
+// containerd and newer docker, are read the same way, their blobs are
+// spooled as layers since the manifest may come last.
+func readImageSave(reader io.Reader, spoolDir string) (*imageSave, error) {
+	save := &imageSave{
+		spoolDir:     spoolDir,
+		configs:      map[string][]byte{},
+		legacy:       map[string]legacyLayerJSON{},
+		layerFiles:   map[string]string{},
+		layerDigests: map[string]string{},
+	}
+	tr := tar.NewReader(reader)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return save, nil
+			}
+			return nil, fmt.Errorf("Unable to read image export: %v\n", err)
+		}
+		name := path.Clean(hdr.Name)
+		switch {
+		case hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA:
+			continue
+		case name == SAVE_MANIFEST_FILE:
+			if err := json.NewDecoder(tr).Decode(&save.manifest); err != nil {
+				return nil, fmt.Errorf("Unable to parse %s: %v\n", SAVE_MANIFEST_FILE, err)
+			}
+		case path.Base(name) == SAVE_LAYER_FILE || path.Ext(name) == ".tar" || strings.HasPrefix(name, SAVE_BLOBS_DIR):
+			if err := save.spoolLayer(name, tr); err != nil {
+				return nil, err
+			}
+		case path.Base(name) == SAVE_LEGACY_JSON:
+			var lj legacyLayerJSON
+			if err := json.NewDecoder(tr).Decode(&lj); err != nil {
+				return nil, fmt.Errorf("Unable to parse %s: %v\n", name, err)
+			}
+			save.legacy[lj.ID] = lj
+		case strings.HasSuffix(name, ".json") && path.Dir(name) == ".":
+			content, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return nil, fmt.Errorf("Unable to read %s: %v\n", name, err)
+			}
+			save.configs[name] = content
+		}
+	}
+}
+
+// spoolLayer copies the layer tarball name to the spool directory computing
+// the digest of the uncompressed tarball on the way, the gzipped layers of
+// the OCI archives being hashed once decompressed.
+func (s *imageSave) spoolLayer(name string, reader io.Reader) error {
+	file, err := ioutil.TempFile(s.spoolDir, "layer-")
+	if err != nil {
+		return fmt.Errorf("Unable to create layer file: %v\n", err)
+	}
+	defer file.Close()
+	buffered := bufio.NewReader(reader)
+	hash := sha256.New()
+	if magic, _ := buffered.Peek(2); len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		err = copyGzipLayer(file, hash, buffered)
+	} else {
+		_, err = io.Copy(io.MultiWriter(file, hash), buffered)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to write layer file: %v\n", err)
+	}
+	s.layerFiles[name] = file.Name()
+	s.layerDigests[name] = "sha256:" + hex.EncodeToString(hash.Sum(nil))
+	return nil
+}
+
+// copyGzipLayer copies the gzipped layer of reader to w and its decompressed
+// tarball to uncompressed.
+func copyGzipLayer(w, uncompressed io.Writer, reader io.Reader) error {
+	pr, pw := io.Pipe()
+	decompressed := make(chan error, 1)
+	go func() {
+		gz, err := gzip.NewReader(pr)
+		if err == nil {
+			_, err = io.Copy(uncompressed, gz)
+		}
+		// a corrupted layer stops the copy
+		pr.CloseWithError(err)
+		decompressed <- err
+	}()
+	_, err := io.Copy(io.MultiWriter(w, pw), reader)
+	pw.Close()
+	if gzErr := <-decompressed; gzErr != nil {
+		return fmt.Errorf("Unable to decompress the layer: %v", gzErr)
+	}
+	return err
+}
+
+// orderedLayers returns the layer tarball names, base layer first.
+func (s *imageSave) orderedLayers(imageID string) ([]string, error) {
+	if len(s.manifest) > 0 {
+		for _, name := range s.manifest[0].Layers {
+			if _, ok := s.layerFiles[path.Clean(name)]; !ok {
+				return nil, fmt.Errorf("Layer %s is missing from the image export\n", name)
+			}
+		}
+		layers := make([]string, len(s.manifest[0].Layers))
+		for idx, name := range s.manifest[0].Layers {
+			layers[idx] = path.Clean(name)
+		}
+		return layers, nil
+	}
+
+	// legacy format: follow the parent chain starting from the image
+	layers := []string{}
+	for id := strings.TrimPrefix(imageID, "sha256:"); id != ""; id = s.legacy[id].Parent {
+		if _, ok := s.legacy[id]; !ok {
+			return nil, fmt.Errorf("Layer %s is missing from the image export\n", id)
+		}
+		layers = append([]string{path.Join(id, SAVE_LAYER_FILE)}, layers...)
+	}
+	return layers, nil
+}
+
+// config returns the content of the image configuration name, a blob of the
+// OCI archives.
+func (s *imageSave) config(name string) []byte {
+	if content, ok := s.configs[name]; ok {
+		return content
+	}
+	if fileName, ok := s.layerFiles[path.Clean(name)]; ok {
+		content, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			log.Printf("WARNING: Unable to read the image configuration: %v", err)
+		}
+		return content
+	}
+	return nil
+}
+
+// layerMetadata builds the metadata of layers using the image configuration
+// history or, lacking that, the history reported by the docker daemon.
+func (s *imageSave) layerMetadata(layers []string, history []docker.ImageHistory) []iiapi.ImageLayer {
+	meta := make([]iiapi.ImageLayer, len(layers))
+	for idx, name := range layers {
+		meta[idx].Digest = s.layerDigests[name]
+	}
+
+	if len(s.manifest) > 0 {
+		var config imageConfig
+		if err := json.Unmarshal(s.config(s.manifest[0].Config), &config); err != nil {
+			log.Printf("WARNING: Unable to parse the image configuration: %v", err)
+		}
+		idx := 0
+		for _, h := range config.History {
+			if h.EmptyLayer {
+				continue
+			}
+			if idx >= len(meta) {
+				break
+			}
+			meta[idx].CreatedBy = h.CreatedBy
+			meta[idx].Created = h.Created
+			idx++
+		}
+		return meta
+	}
+
+	byID := map[string]docker.ImageHistory{}
+	for _, h := range history {
+		byID[strings.TrimPrefix(h.ID, "sha256:")] = h
+	}
+	for idx, name := range layers {
+		id := path.Dir(name)
+		if h, ok := byID[id]; ok {
+			meta[idx].CreatedBy = h.CreatedBy
+			meta[idx].Created = time.Unix(h.Created, 0)
+		} else {
+			meta[idx].Created = s.legacy[id].Created
+		}
+	}
+	return meta
+}
+
+// openLayerFile opens the spooled layer tarball fileName, decompressing the
+// gzipped layers of the OCI archives.
+func openLayerFile(fileName string) (*tar.Reader, io.Closer, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unable to open layer file: %v\n", err)
+	}
+	reader := bufio.NewReader(file)
+	if magic, err := reader.Peek(2); err != nil || magic[0] != 0x1f || magic[1] != 0x8b {
+		return tar.NewReader(reader), file, nil
+	}
+	gz, err := gzip.NewReader(reader)
+	if err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("Unable to decompress layer file: %v\n", err)
+	}
+	return tar.NewReader(gz), file, nil
+}
+
+// applyLayerFile applies the spooled layer tarball fileName on destination.
+func applyLayerFile(fileName, destination string, idx int, layer *iiapi.ImageLayer, files iiapi.LayerFiles, tracker *progress.Tracker) error {
+	tr, file, err := openLayerFile(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return applyLayer(tr, destination, idx, layer, files, tracker)
+}
+
+// applyLayer applies a single image layer on destination handling the
+// whiteout files and recording in files which layer introduced each path
+// and in tracker the files extracted.
+func applyLayer(tr *tar.Reader, destination string, idx int, layer *iiapi.ImageLayer, files iiapi.LayerFiles, tracker *progress.Tracker) error {
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("Unable to extract layer %s: %v\n", layer.Digest, err)
+		}
+
+		name := path.Clean("/" + hdr.Name)
+		if name == "/" {
+			continue
+		}
+		dir, base := path.Split(name)
+		if !isExtractionPathSafe(destination, dir) ||
+			(hdr.Typeflag == tar.TypeLink && !isExtractionPathSafe(destination, path.Dir(path.Clean("/"+hdr.Linkname)))) {
+			log.Printf("WARNING: Skipping %s in layer %s: parent directory is a symlink", name, layer.Digest)
+			continue
+		}
+
+		switch {
+		case base == WHITEOUT_OPAQUE_DIR:
+			if err := removeOpaqueChildren(destination, path.Clean(dir), idx, files); err != nil {
+				return err
+			}
+			continue
+		case strings.HasPrefix(base, WHITEOUT_PREFIX):
+			removed := path.Join(dir, strings.TrimPrefix(base, WHITEOUT_PREFIX))
+			if err := os.RemoveAll(path.Join(destination, removed)); err != nil {
+				return fmt.Errorf("Unable to remove %s: %v\n", removed, err)
+			}
+			layer.Deleted += forgetFiles(files, removed)
+			continue
+		}
+
+		dstpath := path.Join(destination, name)
+		// never write through what a lower layer left behind
+		if fi, err := os.Lstat(dstpath); err == nil && (hdr.Typeflag != tar.TypeDir || !fi.IsDir()) {
+			if err := os.RemoveAll(dstpath); err != nil {
+				return fmt.Errorf("Unable to replace %s: %v\n", name, err)
+			}
+		}
+		linkpath := path.Join(destination, path.Clean("/"+hdr.Linkname))
+		if err := extractTarEntry(tr, hdr, dstpath, linkpath); err != nil {
+			return err
+		}
+
+		if hdr.Typeflag != tar.TypeDir {
+			files[name] = idx
+			layer.Files++
+			tracker.FileExtracted()
+			if hdr.Typeflag == tar.TypeReg || hdr.Typeflag == tar.TypeRegA {
+				layer.Size += hdr.Size
+			}
+		}
+	}
+}
+
+// isExtractionPathSafe makes sure that none of the components of dir inside
+// destination are symlinks, which could lead the extraction outside of it.
+func isExtractionPathSafe(destination, dir string) bool {
+	current := destination
+	for _, component := range strings.Split(path.Clean(dir), "/") {
+		if component == "" {
+			continue
+		}
+		current = path.Join(current, component)
+		fi, err := os.Lstat(current)
+		if err != nil {
+			return os.IsNotExist(err)
+		}
+		if fi.Mode()&os.ModeSymlink != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// removeOpaqueChildren removes the content of dir that was not added by the
+// layer idx, as requested by an opaque whiteout.
+func removeOpaqueChildren(destination, dir string, idx int, files iiapi.LayerFiles) error {
+	entries, err := ioutil.ReadDir(path.Join(destination, dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("Unable to read directory %s: %v\n", dir, err)
+	}
+	for _, entry := range entries {
+		child := path.Join(dir, entry.Name())
+		if layer, ok := files[child]; ok && layer == idx {
+			continue
+		}
+		if entry.IsDir() && hasFilesFromLayer(files, child, idx) {
+			// the directory was recreated by this layer, clean it up recursively
+			if err := removeOpaqueChildren(destination, child, idx, files); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := os.RemoveAll(path.Join(destination, child)); err != nil {
+			return fmt.Errorf("Unable to remove %s: %v\n", child, err)
+		}
+		forgetFiles(files, child)
+	}
+	return nil
+}
+
+// hasFilesFromLayer returns true if any file under dir was added by layer idx.
+func hasFilesFromLayer(files iiapi.LayerFiles, dir string, idx int) bool {
+	for name, layer := range files {
+		if layer == idx && strings.HasPrefix(name, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// forgetFiles removes name and everything below it from files, returning the
+// number of removed entries.
+func forgetFiles(files iiapi.LayerFiles, name string) int {
+	removed := 0
+	for file := range files {
+		if file == name || strings.HasPrefix(file, name+"/") {
+			delete(files, file)
+			removed++
+		}
+	}
+	return removed
+}
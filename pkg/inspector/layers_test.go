@@ -0,0 +1,241 @@
+package inspector
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+type tarEntry struct {
+	name     string
+	typeflag byte
+	content  string
+	linkname string
+}
+
+func mkTar(t *testing.T, entries []tarEntry) []byte {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for _, e := range entries {
+		hdr := &tar.Header{
+			Name:     e.name,
+			Typeflag: e.typeflag,
+			Mode:     0644,
+			Size:     int64(len(e.content)),
+			Linkname: e.linkname,
+		}
+		if e.typeflag == tar.TypeDir {
+			hdr.Mode = 0755
+		}
+		if e.typeflag != tar.TypeReg {
+			hdr.Size = 0
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatalf("Unable to write tar header: %v", err)
+		}
+		if hdr.Size > 0 {
+			if _, err := tw.Write([]byte(e.content)); err != nil {
+				t.Fatalf("Unable to write tar content: %v", err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("Unable to close tar: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func mkImageSave(t *testing.T) []byte {
+	base := mkTar(t, []tarEntry{
+		{name: "etc/", typeflag: tar.TypeDir},
+		{name: "etc/passwd", typeflag: tar.TypeReg, content: "root:x:0:0"},
+		{name: "etc/motd", typeflag: tar.TypeReg, content: "hello"},
+		{name: "opt/", typeflag: tar.TypeDir},
+		{name: "opt/old", typeflag: tar.TypeReg, content: "old"},
+		{name: "lib", typeflag: tar.TypeSymlink, linkname: "/usr/lib"},
+	})
+	top := mkTar(t, []tarEntry{
+		{name: "etc/", typeflag: tar.TypeDir},
+		{name: "etc/.wh.motd", typeflag: tar.TypeReg},
+		{name: "etc/passwd", typeflag: tar.TypeReg, content: "root:x:0:0\nuser:x:1000:1000"},
+		{name: "opt/", typeflag: tar.TypeDir},
+		{name: "opt/new", typeflag: tar.TypeReg, content: "new"},
+		{name: "opt/.wh..wh..opq", typeflag: tar.TypeReg},
+		{name: "lib/evil", typeflag: tar.TypeReg, content: "evil"},
+	})
+	return mkTar(t, []tarEntry{
+		{name: "aaaa/", typeflag: tar.TypeDir},
+		{name: "aaaa/layer.tar", typeflag: tar.TypeReg, content: string(top)},
+		{name: "bbbb/", typeflag: tar.TypeDir},
+		{name: "bbbb/layer.tar", typeflag: tar.TypeReg, content: string(base)},
+		{name: "cccc.json", typeflag: tar.TypeReg, content: `{"history": [
+			{"created_by": "ADD base.tar /"},
+			{"created_by": "ENV FOO=bar", "empty_layer": true},
+			{"created_by": "RUN useradd user"}]}`},
+		{name: "manifest.json", typeflag: tar.TypeReg,
+			content: `[{"Config": "cccc.json", "Layers": ["bbbb/layer.tar", "aaaa/layer.tar"]}]`},
+	})
+}
+
+func TestExtractImageSave(t *testing.T) {
+	spoolDir, err := ioutil.TempDir("", "layers-spool-")
+	if err != nil {
+		t.Fatalf("Unable to create spool dir: %v", err)
+	}
+	defer os.RemoveAll(spoolDir)
+	dstDir, err := ioutil.TempDir("", "layers-dst-")
+	if err != nil {
+		t.Fatalf("Unable to create destination dir: %v", err)
+	}
+	defer os.RemoveAll(dstDir)
+
+	save, err := readImageSave(bytes.NewReader(mkImageSave(t)), spoolDir)
+	if err != nil {
+		t.Fatalf("Unable to read image save: %v", err)
+	}
+	layers, err := save.orderedLayers("")
+	if err != nil {
+		t.Fatalf("Unable to order layers: %v", err)
+	}
+	if len(layers) != 2 || layers[0] != "bbbb/layer.tar" || layers[1] != "aaaa/layer.tar" {
+		t.Fatalf("Unexpected layer order %v", layers)
+	}
+
+	meta := iiapi.InspectorMetadata{Layers: save.layerMetadata(layers, nil)}
+	files := iiapi.LayerFiles{}
+	for idx, name := range layers {
+		if err := applyLayerFile(save.layerFiles[name], dstDir, idx, &meta.Layers[idx], files, nil); err != nil {
+			t.Fatalf("Unable to apply layer %s: %v", name, err)
+		}
+	}
+
+	if meta.Layers[0].CreatedBy != "ADD base.tar /" || meta.Layers[1].CreatedBy != "RUN useradd user" {
+		t.Errorf("Unexpected layer history %+v", meta.Layers)
+	}
+	if len(meta.Layers[0].Digest) == 0 || meta.Layers[0].Digest == meta.Layers[1].Digest {
+		t.Errorf("Unexpected layer digests %+v", meta.Layers)
+	}
+	if meta.Layers[0].Files != 4 || meta.Layers[0].Size != int64(len("root:x:0:0hello"+"old")) {
+		t.Errorf("Unexpected base layer breakdown %+v", meta.Layers[0])
+	}
+	if meta.Layers[1].Deleted != 1 {
+		t.Errorf("Expected the top layer to delete one file, got %d", meta.Layers[1].Deleted)
+	}
+
+	for name, expected := range map[string]*iiapi.ImageLayer{
+		"/etc/passwd": &meta.Layers[1],
+		"/lib":        &meta.Layers[0],
+		"/opt/new":    &meta.Layers[1],
+		"/etc/motd":   nil,
+		"/opt/old":    nil,
+		"/lib/evil":   nil,
+	} {
+		if layer := meta.LayerOf(files, name); layer != expected {
+			t.Errorf("%s expected to be in layer %v but got %v", name, expected, layer)
+		}
+	}
+
+	for name, exists := range map[string]bool{
+		"etc/passwd": true,
+		"opt/new":    true,
+		"etc/motd":   false,
+		"opt/old":    false,
+	} {
+		_, err := os.Lstat(path.Join(dstDir, name))
+		if exists && err != nil {
+			t.Errorf("%s should have been extracted: %v", name, err)
+		}
+		if !exists && !os.IsNotExist(err) {
+			t.Errorf("%s should have been removed", name)
+		}
+	}
+	content, err := ioutil.ReadFile(path.Join(dstDir, "etc/passwd"))
+	if err != nil || string(content) != "root:x:0:0\nuser:x:1000:1000" {
+		t.Errorf("etc/passwd was not overwritten by the top layer: %q %v", content, err)
+	}
+}
+
+func TestExtractOCIArchive(t *testing.T) {
+	spoolDir, err := ioutil.TempDir("", "layers-spool-")
+	if err != nil {
+		t.Fatalf("Unable to create spool dir: %v", err)
+	}
+	defer os.RemoveAll(spoolDir)
+	dstDir, err := ioutil.TempDir("", "layers-dst-")
+	if err != nil {
+		t.Fatalf("Unable to create destination dir: %v", err)
+	}
+	defer os.RemoveAll(dstDir)
+
+	gzipped := &bytes.Buffer{}
+	gz := gzip.NewWriter(gzipped)
+	layer := mkTar(t, []tarEntry{
+		{name: "etc/", typeflag: tar.TypeDir},
+		{name: "etc/os-release", typeflag: tar.TypeReg, content: "ID=fedora"},
+	})
+	gz.Write(layer)
+	gz.Close()
+	archive := mkTar(t, []tarEntry{
+		{name: "oci-layout", typeflag: tar.TypeReg, content: `{"imageLayoutVersion": "1.0.0"}`},
+		{name: "blobs/", typeflag: tar.TypeDir},
+		{name: "blobs/sha256/", typeflag: tar.TypeDir},
+		{name: "blobs/sha256/1111", typeflag: tar.TypeReg, content: gzipped.String()},
+		{name: "blobs/sha256/2222", typeflag: tar.TypeReg, content: `{"history": [{"created_by": "ADD rootfs.tar /"}]}`},
+		{name: "manifest.json", typeflag: tar.TypeReg,
+			content: `[{"Config": "blobs/sha256/2222", "Layers": ["blobs/sha256/1111"]}]`},
+	})
+
+	save, err := readImageSave(bytes.NewReader(archive), spoolDir)
+	if err != nil {
+		t.Fatalf("Unable to read the OCI archive: %v", err)
+	}
+	layers, err := save.orderedLayers("")
+	if err != nil || len(layers) != 1 || layers[0] != "blobs/sha256/1111" {
+		t.Fatalf("Unexpected layers %v: %v", layers, err)
+	}
+	meta := save.layerMetadata(layers, nil)
+	if err := applyLayerFile(save.layerFiles[layers[0]], dstDir, 0, &meta[0], iiapi.LayerFiles{}, nil); err != nil {
+		t.Fatalf("Unable to apply the gzipped layer: %v", err)
+	}
+	if meta[0].CreatedBy != "ADD rootfs.tar /" || meta[0].Files != 1 {
+		t.Errorf("Unexpected layer %+v", meta[0])
+	}
+	if sum := sha256.Sum256(layer); meta[0].Digest != "sha256:"+hex.EncodeToString(sum[:]) {
+		t.Errorf("Expected the digest of the uncompressed layer but got %s", meta[0].Digest)
+	}
+	if content, err := ioutil.ReadFile(path.Join(dstDir, "etc/os-release")); err != nil || string(content) != "ID=fedora" {
+		t.Errorf("etc/os-release was not extracted: %q %v", content, err)
+	}
+}
+
+func TestOrderedLayersMissing(t *testing.T) {
+	save := &imageSave{
+		manifest:   []saveManifestEntry{{Layers: []string{"nosuchlayer/layer.tar"}}},
+		layerFiles: map[string]string{},
+	}
+	if _, err := save.orderedLayers(""); err == nil {
+		t.Errorf("a missing layer should have failed")
+	}
+
+	legacy := &imageSave{
+		legacy: map[string]legacyLayerJSON{
+			"top":  {ID: "top", Parent: "base"},
+			"base": {ID: "base"},
+		},
+	}
+	layers, err := legacy.orderedLayers("top")
+	if err != nil || len(layers) != 2 || layers[0] != "base/layer.tar" {
+		t.Errorf("Unexpected legacy layers %v: %v", layers, err)
+	}
+	if _, err := legacy.orderedLayers("unknown"); err == nil {
+		t.Errorf("an unknown image should have failed")
+	}
+}
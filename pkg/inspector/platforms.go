@@ -0,0 +1,240 @@
+package inspector
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+	"github.com/openshift/image-inspector/pkg/registry"
+	"github.com/openshift/image-inspector/pkg/store"
+)
+
+// registryClient reads the manifests and the blobs of the images from their
+// registry.
+type registryClient interface {
+	GetManifest(ref *registry.Reference) (*registry.Manifest, error)
+	GetBlob(ref *registry.Reference, digest string) ([]byte, error)
+}
+
+// newRegistryClient provides an injectable way to reach the registries for testing.
+var newRegistryClient = func(auth docker.AuthConfiguration) registryClient {
+	return registry.NewClient(auth)
+}
+
+// getManifest returns the manifest of ref from its registry. It will try to
+// use all the given authentication methods and will fail only if all of them
+// failed. The authentication that succeeded is kept to reach the registry
+// again.
+func (i *defaultImageInspector) getManifest(ref *registry.Reference) (*registry.Manifest, error) {
+	auths, err := i.getAuthConfigs()
+	if err != nil {
+		return nil, err
+	}
+	var manifestErr error
+	for name, auth := range auths.Configs {
+		manifest, err := newRegistryClient(auth).GetManifest(ref)
+		if err == nil {
+			i.registryAuth = &auth
+			return manifest, nil
+		}
+		manifestErr = err
+		log.Printf("Authentication with %s failed: %v", name, err)
+	}
+	return nil, manifestErr
+}
+
+// selectPlatform points the option's image to the manifest of the option's
+// platform in its manifest list. The references by digest and the images
+// without a manifest list are kept as they are, their platform being checked
+// once extracted.
+func (i *defaultImageInspector) selectPlatform() error {
+	platform, err := registry.ParsePlatform(i.opts.Platform)
+	if err != nil {
+		return err
+	}
+	i.meta.Platform = &platform
+	if store.IsDigestReference(i.opts.Image) {
+		return nil
+	}
+	ref, err := registry.ParseReference(i.opts.Image)
+	if err != nil {
+		return err
+	}
+	manifest, err := i.getManifest(ref)
+	if err != nil {
+		return err
+	}
+	if !registry.IsManifestList(manifest.MediaType) {
+		log.Printf("%s has no manifest list, checking its platform once extracted", i.opts.Image)
+		i.manifest = manifest
+		return nil
+	}
+	descriptors, err := manifest.Platforms()
+	if err != nil {
+		return err
+	}
+	desc, err := registry.MatchPlatform(descriptors, platform)
+	if err != nil {
+		return fmt.Errorf("Unable to select the platform of %s: %v\n", i.opts.Image, err)
+	}
+	log.Printf("Selected the %s platform of %s: %s", registry.PlatformOf(*desc), i.opts.Image, desc.Digest)
+	i.opts.Image = ref.WithDigest(desc.Digest)
+	return nil
+}
+
+// checkPlatform fails when image isn't of the selected platform, setting its
+// architecture when the runtime doesn't report it. The OS and the variant,
+// which the runtime doesn't report, are those of the configuration of the
+// manifest the image was pulled by; only the architecture is checked when
+// the manifest is unknown.
+func (i *defaultImageInspector) checkPlatform(image *docker.Image) error {
+	if i.meta.Platform == nil {
+		return nil
+	}
+	if len(image.Architecture) == 0 {
+		image.Architecture = i.meta.Platform.Architecture
+	}
+	platform, err := i.configPlatform()
+	if err != nil {
+		log.Printf("WARNING: Unable to get the platform of %s, only checking its architecture: %v", i.opts.Image, err)
+	}
+	if platform == nil {
+		platform = &iiapi.Platform{OS: i.meta.Platform.OS, Architecture: image.Architecture, Variant: i.meta.Platform.Variant}
+	}
+	if platform.Architecture != i.meta.Platform.Architecture || platform.OS != i.meta.Platform.OS ||
+		(len(platform.Variant) > 0 && len(i.meta.Platform.Variant) > 0 && platform.Variant != i.meta.Platform.Variant) {
+		return fmt.Errorf("The image %s is built for %s, not for %s\n", i.opts.Image, platform, i.meta.Platform)
+	}
+	return nil
+}
+
+// configPlatform returns the platform of the image configuration of the
+// manifest the image was pulled by, read from its registry, nil when the
+// manifest is a manifest list.
+func (i *defaultImageInspector) configPlatform() (*iiapi.Platform, error) {
+	ref, err := registry.ParseReference(i.opts.Image)
+	if err != nil {
+		return nil, err
+	}
+	if i.manifest == nil {
+		if i.manifest, err = i.getManifest(ref); err != nil {
+			return nil, err
+		}
+	}
+	configDigest, err := i.manifest.ConfigDigest()
+	if err != nil || len(configDigest) == 0 {
+		return nil, err
+	}
+	auth := docker.AuthConfiguration{}
+	if i.registryAuth != nil {
+		auth = *i.registryAuth
+	}
+	content, err := newRegistryClient(auth).GetBlob(ref, configDigest)
+	if err != nil {
+		return nil, err
+	}
+	var config struct {
+		OS           string `json:"os"`
+		Architecture string `json:"architecture"`
+		Variant      string `json:"variant"`
+	}
+	if err := json.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("Unable to parse the image configuration: %v", err)
+	}
+	return &iiapi.Platform{OS: config.OS, Architecture: config.Architecture, Variant: config.Variant}, nil
+}
+
+// platformsInspector inspects every platform of the manifest list of an
+// image, one after the other, each with its own default inspector.
+type platformsInspector struct {
+	opts    iicmd.ImageInspectorOptions
+	reports []iiapi.InspectorReport
+}
+
+// ensures this always implements the interface or fail compilation.
+var _ ImageInspector = &platformsInspector{}
+
+// Inspect inspects all the platforms of the image, going on when the
+// inspection of one of them fails, and writes the report of all of them.
+func (p *platformsInspector) Inspect() error {
+	ref, err := registry.ParseReference(p.opts.Image)
+	if err != nil {
+		return err
+	}
+	ii := &defaultImageInspector{opts: p.opts}
+	manifest, err := ii.getManifest(ref)
+	if err != nil {
+		return err
+	}
+	descriptors, err := manifest.Platforms()
+	if err != nil {
+		return fmt.Errorf("Unable to inspect all the platforms of %s: %v\n", p.opts.Image, err)
+	}
+	// the platforms are inspected in subdirectories of the given directories
+	for _, dir := range []string{p.opts.DstPath, p.opts.ScanResultsDir} {
+		if len(dir) > 0 {
+			if _, err := createOutputDir(dir, ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	failed := []string{}
+	for _, desc := range descriptors {
+		platform := registry.PlatformOf(desc)
+		opts := p.opts
+		opts.AllPlatforms = false
+		opts.Platform = platform.String()
+		opts.Image = ref.WithDigest(desc.Digest)
+		opts.DstPath = platformPath(opts.DstPath, platform)
+		opts.ScanResultsDir = platformPath(opts.ScanResultsDir, platform)
+		// the report of all the platforms is written once they are inspected
+		opts.Output = ""
+		opts.OutputFile = ""
+
+		log.Printf("Inspecting the %s platform of %s", platform, p.opts.Image)
+		inspector := NewDefaultImageInspector(opts)
+		if err := inspector.Inspect(); err != nil {
+			log.Printf("Unable to inspect the %s platform of %s: %v", platform, p.opts.Image, err)
+			failed = append(failed, platform.String())
+		}
+		p.reports = append(p.reports, *inspector.Report())
+	}
+	if len(p.opts.Output) > 0 {
+		if err := writeReport(p.opts, p.Report()); err != nil {
+			return err
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("Unable to inspect the platforms %s of %s\n", strings.Join(failed, ", "), p.opts.Image)
+	}
+	return nil
+}
+
+// Report returns the reports of all the platforms.
+func (p *platformsInspector) Report() *iiapi.InspectorReport {
+	return &iiapi.InspectorReport{
+		Image:     p.opts.Image,
+		Findings:  []iiapi.Finding{},
+		Platforms: p.reports,
+	}
+}
+
+// platformName is the name of the files and directories of platform.
+func platformName(platform iiapi.Platform) string {
+	return strings.Replace(platform.String(), "/", "-", -1)
+}
+
+// platformPath returns the subdirectory of dir for platform, empty when dir is.
+func platformPath(dir string, platform iiapi.Platform) string {
+	if len(dir) == 0 {
+		return dir
+	}
+	return path.Join(dir, platformName(platform))
+}
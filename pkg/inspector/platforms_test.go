@@ -0,0 +1,210 @@
+package inspector
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+	"github.com/openshift/image-inspector/pkg/registry"
+)
+
+// fakeRegistryClient serves the manifest list of fedora:26, the manifest of
+// its amd64 platform and the single manifest of centos:7, and the image
+// configurations of the arm variants.
+type fakeRegistryClient struct{}
+
+func (g *fakeRegistryClient) GetManifest(ref *registry.Reference) (*registry.Manifest, error) {
+	switch ref.String() {
+	case "docker.io/library/fedora:26":
+		return &registry.Manifest{
+			MediaType: registry.MEDIA_TYPE_MANIFEST_LIST,
+			Digest:    "sha256:0000",
+			Content: []byte(`{"manifests": [
+				{"digest": "sha256:1111", "platform": {"os": "linux", "architecture": "amd64"}},
+				{"digest": "sha256:2222", "platform": {"os": "linux", "architecture": "s390x"}}]}`),
+		}, nil
+	case "docker.io/library/fedora@sha256:1111":
+		return &registry.Manifest{MediaType: registry.MEDIA_TYPE_MANIFEST, Digest: "sha256:1111",
+			Content: []byte(`{"config": {"digest": "sha256:4444"}}`)}, nil
+	case "docker.io/library/centos:7":
+		return &registry.Manifest{MediaType: registry.MEDIA_TYPE_MANIFEST, Digest: "sha256:3333",
+			Content: []byte(`{"config": {"digest": "sha256:5555"}}`)}, nil
+	}
+	return nil, fmt.Errorf("manifest unknown")
+}
+
+func (g *fakeRegistryClient) GetBlob(ref *registry.Reference, digest string) ([]byte, error) {
+	switch digest {
+	case "sha256:6666":
+		return []byte(`{"os": "linux", "architecture": "arm", "variant": "v6"}`), nil
+	case "sha256:7777":
+		return []byte(`{"os": "linux", "architecture": "arm", "variant": "v7"}`), nil
+	}
+	return nil, fmt.Errorf("blob unknown")
+}
+
+func TestSelectPlatform(t *testing.T) {
+	oldNewRegistryClient := newRegistryClient
+	defer func() { newRegistryClient = oldNewRegistryClient }()
+	newRegistryClient = func(docker.AuthConfiguration) registryClient { return &fakeRegistryClient{} }
+
+	for k, v := range map[string]struct {
+		image, platform string
+		expected        string
+		shouldFail      bool
+	}{
+		"platform of a manifest list":   {image: "fedora:26", platform: "linux/s390x", expected: "docker.io/library/fedora@sha256:2222"},
+		"no such platform":              {image: "fedora:26", platform: "linux/arm64", shouldFail: true},
+		"image without a manifest list": {image: "centos:7", platform: "linux/amd64", expected: "centos:7"},
+		"reference by digest":           {image: "fedora@sha256:1111", platform: "linux/amd64", expected: "fedora@sha256:1111"},
+		"no such image":                 {image: "debian:9", platform: "linux/amd64", shouldFail: true},
+	} {
+		opts := iicmd.NewDefaultImageInspectorOptions()
+		opts.Image = v.image
+		opts.Platform = v.platform
+		ii := &defaultImageInspector{opts: *opts}
+		err := ii.selectPlatform()
+		if v.shouldFail != (err != nil) {
+			t.Errorf("%s: unexpected error %v", k, err)
+			continue
+		}
+		if err == nil && (ii.opts.Image != v.expected || ii.meta.Platform == nil || ii.meta.Platform.String() != v.platform) {
+			t.Errorf("%s: expected %s for %s but got %s for %v", k, v.expected, v.platform, ii.opts.Image, ii.meta.Platform)
+		}
+	}
+}
+
+func TestCheckPlatform(t *testing.T) {
+	ii := &defaultImageInspector{meta: iiapi.InspectorMetadata{Platform: &iiapi.Platform{OS: "linux", Architecture: "arm64"}}}
+	image := &docker.Image{}
+	if err := ii.checkPlatform(image); err != nil || image.Architecture != "arm64" {
+		t.Errorf("Expected the architecture of the platform but got %q: %v", image.Architecture, err)
+	}
+	if err := ii.checkPlatform(&docker.Image{Architecture: "amd64"}); err == nil {
+		t.Errorf("An image of another architecture should have failed")
+	}
+	ii.meta.Platform = nil
+	if err := ii.checkPlatform(&docker.Image{Architecture: "amd64"}); err != nil {
+		t.Errorf("Without a platform any image should be accepted: %v", err)
+	}
+
+	oldNewRegistryClient := newRegistryClient
+	defer func() { newRegistryClient = oldNewRegistryClient }()
+	newRegistryClient = func(docker.AuthConfiguration) registryClient { return &fakeRegistryClient{} }
+	for k, v := range map[string]struct {
+		platform     iiapi.Platform
+		configDigest string
+		shouldFail   bool
+	}{
+		"same variant":          {platform: iiapi.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, configDigest: "sha256:7777"},
+		"other variant":         {platform: iiapi.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, configDigest: "sha256:6666", shouldFail: true},
+		"other os":              {platform: iiapi.Platform{OS: "windows", Architecture: "arm", Variant: "v6"}, configDigest: "sha256:6666", shouldFail: true},
+		"unknown configuration": {platform: iiapi.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}, configDigest: "sha256:8888"},
+	} {
+		ii := &defaultImageInspector{
+			opts: iicmd.ImageInspectorOptions{Image: "docker.io/library/fedora@sha256:1111"},
+			meta: iiapi.InspectorMetadata{Platform: &v.platform},
+			manifest: &registry.Manifest{MediaType: registry.MEDIA_TYPE_MANIFEST,
+				Content: []byte(fmt.Sprintf(`{"config": {"digest": "%s"}}`, v.configDigest))},
+		}
+		if err := ii.checkPlatform(&docker.Image{Architecture: "arm"}); v.shouldFail != (err != nil) {
+			t.Errorf("%s: unexpected error %v", k, err)
+		}
+	}
+}
+
+func TestInspectAllPlatforms(t *testing.T) {
+	oldNewRegistryClient := newRegistryClient
+	defer func() { newRegistryClient = oldNewRegistryClient }()
+	newRegistryClient = func(docker.AuthConfiguration) registryClient { return &fakeRegistryClient{} }
+
+	dstDir, err := ioutil.TempDir("", "platforms-dst-")
+	if err != nil {
+		t.Fatalf("Unable to create destination dir: %v", err)
+	}
+	defer os.RemoveAll(dstDir)
+
+	opts := iicmd.NewDefaultImageInspectorOptions()
+	opts.URI = "unix://" + path.Join(dstDir, "docker.sock")
+	opts.Image = "fedora:26"
+	opts.AllPlatforms = true
+	opts.DstPath = path.Join(dstDir, "rootfs")
+	opts.Output = "json"
+	opts.OutputFile = path.Join(dstDir, "report.json")
+	inspector := NewDefaultImageInspector(*opts)
+	// no daemon listens on the socket, every platform fails to be pulled
+	err = inspector.Inspect()
+	if err == nil || !strings.Contains(err.Error(), "linux/amd64, linux/s390x") {
+		t.Errorf("Expected both platforms to fail but got %v", err)
+	}
+	report := inspector.Report()
+	if len(report.Platforms) != 2 {
+		t.Fatalf("Expected a report per platform but got %+v", report)
+	}
+	for idx, expected := range []string{"docker.io/library/fedora@sha256:1111", "docker.io/library/fedora@sha256:2222"} {
+		platform := report.Platforms[idx]
+		if platform.Image != expected || platform.Metadata.Platform == nil {
+			t.Errorf("Unexpected report of platform %d: %s %v", idx, platform.Image, platform.Metadata.Platform)
+		}
+	}
+	if _, err := os.Stat(opts.DstPath); err != nil {
+		t.Errorf("The destination of the platforms wasn't created: %v", err)
+	}
+	content, err := ioutil.ReadFile(opts.OutputFile)
+	if err != nil {
+		t.Fatalf("The report of the platforms wasn't written: %v", err)
+	}
+	written := iiapi.InspectorReport{}
+	if err := json.Unmarshal(content, &written); err != nil || len(written.Platforms) != 2 {
+		t.Errorf("Expected a single report of both platforms but got %s: %v", content, err)
+	}
+
+	opts.Image = "centos:7"
+	if err := NewDefaultImageInspector(*opts).Inspect(); err == nil || !strings.Contains(err.Error(), "not a manifest list") {
+		t.Errorf("Expected an image without a manifest list to fail but got %v", err)
+	}
+}
+
+func TestPlatformFiles(t *testing.T) {
+	platform := iiapi.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}
+	if dir := platformPath("/var/tmp/rootfs", platform); dir != "/var/tmp/rootfs/linux-arm-v7" {
+		t.Errorf("Unexpected platform directory %s", dir)
+	}
+	if dir := platformPath("", platform); dir != "" {
+		t.Errorf("Expected no platform directory but got %s", dir)
+	}
+}
+
+func TestResolveDigest(t *testing.T) {
+	oldNewRegistryClient := newRegistryClient
+	defer func() { newRegistryClient = oldNewRegistryClient }()
+	newRegistryClient = func(docker.AuthConfiguration) registryClient { return &fakeRegistryClient{} }
+
+	for k, v := range map[string]struct {
+		image      string
+		expected   string
+		shouldFail bool
+	}{
+		"manifest list":       {image: "fedora:26", expected: "docker.io/library/fedora@sha256:0000"},
+		"manifest":            {image: "docker.io/library/centos:7", expected: "docker.io/library/centos@sha256:3333"},
+		"reference by digest": {image: "fedora@sha256:1111", expected: "fedora@sha256:1111"},
+		"no such image":       {image: "debian:9", shouldFail: true},
+	} {
+		digest, err := ResolveDigest(*iicmd.NewDefaultImageInspectorOptions(), v.image)
+		if v.shouldFail != (err != nil) {
+			t.Errorf("%s: unexpected error %v", k, err)
+			continue
+		}
+		if digest != v.expected {
+			t.Errorf("%s: expected %s but got %s", k, v.expected, digest)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package inspector
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/registry"
+)
+
+// resolveImage pins the option's image to the digest of its manifest in its
+// registry before it's pulled, recording where it comes from. reference is
+// the image the inspection was asked for. When the registry can't be reached
+// the references by digest are pulled as they are and the tags fail, unless
+// AllowUnresolvedTag, pulling them unresolved. The image ids are local, they
+// have no provenance.
+func (i *defaultImageInspector) resolveImage(reference string) error {
+	if strings.HasPrefix(i.opts.Image, "sha256:") {
+		return nil
+	}
+	ref, err := registry.ParseReference(i.opts.Image)
+	if err != nil {
+		return err
+	}
+	i.meta.Provenance = &iiapi.ImageProvenance{
+		Reference: reference,
+		Registry:  ref.Registry,
+	}
+	if len(ref.Digest) > 0 {
+		i.meta.Provenance.RepoDigest = ref.WithDigest(ref.Digest)
+	}
+
+	manifest, err := i.getManifest(ref)
+	if err != nil && len(ref.Digest) > 0 {
+		log.Printf("WARNING: Unable to get the manifest of %s, pulling it by its digest: %v", i.opts.Image, err)
+		return nil
+	} else if err != nil && !i.opts.AllowUnresolvedTag {
+		return fmt.Errorf("Unable to resolve the digest of %s before pulling it: %v\n", i.opts.Image, err)
+	} else if err != nil {
+		log.Printf("WARNING: Unable to resolve the digest of %s, pulling the tag unresolved: %v", i.opts.Image, err)
+		i.meta.Provenance.Unresolved = true
+		return nil
+	}
+	i.manifest = manifest
+	i.meta.Provenance.RepoDigest = ref.WithDigest(manifest.Digest)
+	i.meta.Provenance.MediaType = manifest.MediaType
+	if len(ref.Digest) == 0 {
+		log.Printf("Resolved %s to %s", i.opts.Image, i.meta.Provenance.RepoDigest)
+	}
+	i.opts.Image = i.meta.Provenance.RepoDigest
+	return nil
+}
+
+// verifyDigest checks the extracted image is the one of the manifest it was
+// pulled by: its id is the digest of the configuration of the manifest or,
+// for the manifest lists, the runtime recorded it was pulled by their
+// digest. Without the manifest its digest is taken from the runtime.
+func (i *defaultImageInspector) verifyDigest(image *docker.Image) error {
+	provenance := i.meta.Provenance
+	if provenance == nil {
+		return nil
+	}
+	if i.manifest == nil {
+		if len(provenance.RepoDigest) == 0 {
+			if digest := repoDigest(provenance.Reference, image.RepoDigests); len(digest) > 0 {
+				provenance.RepoDigest = digest
+			}
+		}
+		log.Printf("WARNING: Unable to verify %s, its manifest is unknown", i.opts.Image)
+		return nil
+	}
+
+	configDigest, err := i.manifest.ConfigDigest()
+	if err != nil {
+		return fmt.Errorf("Unable to verify %s: %v\n", i.opts.Image, err)
+	}
+	if len(configDigest) > 0 {
+		if image.ID != configDigest {
+			return fmt.Errorf("The image %s doesn't match its manifest, its id is %s instead of %s\n",
+				i.opts.Image, image.ID, configDigest)
+		}
+		provenance.Verified = true
+		return nil
+	}
+
+	if len(image.RepoDigests) == 0 {
+		log.Printf("WARNING: Unable to verify %s, the %s runtime doesn't report its digests", i.opts.Image, i.opts.Runtime)
+		return nil
+	}
+	for _, digest := range image.RepoDigests {
+		if strings.HasSuffix(digest, "@"+i.manifest.Digest) {
+			provenance.Verified = true
+			return nil
+		}
+	}
+	return fmt.Errorf("The image %s doesn't match its manifest list, its digests are %v\n", i.opts.Image, image.RepoDigests)
+}
@@ -0,0 +1,72 @@
+package inspector
+
+import (
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+)
+
+func TestResolveAndVerifyDigest(t *testing.T) {
+	oldNewRegistryClient := newRegistryClient
+	defer func() { newRegistryClient = oldNewRegistryClient }()
+	newRegistryClient = func(docker.AuthConfiguration) registryClient { return &fakeRegistryClient{} }
+
+	for k, v := range map[string]struct {
+		image      string
+		allow      bool
+		pulled     *docker.Image
+		expected   string
+		mediaType  string
+		verified   bool
+		unresolved bool
+		shouldFail bool
+	}{
+		"tag of a manifest": {image: "centos:7", pulled: &docker.Image{ID: "sha256:5555"},
+			expected: "docker.io/library/centos@sha256:3333", mediaType: "application/vnd.docker.distribution.manifest.v2+json", verified: true},
+		"tag of a manifest list": {image: "fedora:26", pulled: &docker.Image{ID: "sha256:4444", RepoDigests: []string{"fedora@sha256:0000"}},
+			expected: "docker.io/library/fedora@sha256:0000", mediaType: "application/vnd.docker.distribution.manifest.list.v2+json", verified: true},
+		"reference by digest": {image: "fedora@sha256:1111", pulled: &docker.Image{ID: "sha256:4444"},
+			expected: "docker.io/library/fedora@sha256:1111", mediaType: "application/vnd.docker.distribution.manifest.v2+json", verified: true},
+		"image of another manifest": {image: "centos:7", pulled: &docker.Image{ID: "sha256:4444"}, shouldFail: true},
+		"image of another manifest list": {image: "fedora:26", pulled: &docker.Image{ID: "sha256:4444", RepoDigests: []string{"fedora@sha256:1111"}},
+			shouldFail: true},
+		"unknown digests of a manifest list": {image: "fedora:26", pulled: &docker.Image{ID: "sha256:4444"},
+			expected: "docker.io/library/fedora@sha256:0000", mediaType: "application/vnd.docker.distribution.manifest.list.v2+json"},
+		"registry not reachable": {image: "debian", pulled: &docker.Image{ID: "sha256:6666", RepoDigests: []string{"debian@sha256:7777"}},
+			shouldFail: true},
+		"registry not reachable allowing unresolved tags": {image: "debian", allow: true,
+			pulled:   &docker.Image{ID: "sha256:6666", RepoDigests: []string{"debian@sha256:7777"}},
+			expected: "debian@sha256:7777", unresolved: true},
+		"registry not reachable by digest": {image: "debian@sha256:7777", pulled: &docker.Image{ID: "sha256:6666"},
+			expected: "docker.io/library/debian@sha256:7777"},
+	} {
+		opts := iicmd.NewDefaultImageInspectorOptions()
+		opts.Image = v.image
+		opts.AllowUnresolvedTag = v.allow
+		ii := &defaultImageInspector{opts: *opts}
+		err := ii.resolveImage(v.image)
+		if err == nil {
+			err = ii.verifyDigest(v.pulled)
+		}
+		if v.shouldFail != (err != nil) {
+			t.Errorf("%s: unexpected error %v", k, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		provenance := ii.meta.Provenance
+		if provenance.Reference != v.image || provenance.Registry != "docker.io" || provenance.RepoDigest != v.expected ||
+			provenance.MediaType != v.mediaType || provenance.Verified != v.verified || provenance.Unresolved != v.unresolved {
+			t.Errorf("%s: unexpected provenance %+v", k, provenance)
+		}
+	}
+
+	ii := &defaultImageInspector{}
+	ii.opts.Image = "sha256:4444"
+	if err := ii.resolveImage(ii.opts.Image); err != nil || ii.meta.Provenance != nil {
+		t.Errorf("An image id should have no provenance: %+v %v", ii.meta.Provenance, err)
+	}
+}
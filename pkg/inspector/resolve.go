@@ -0,0 +1,49 @@
+package inspector
+
+import (
+	"fmt"
+	"strings"
+
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+	"github.com/openshift/image-inspector/pkg/registry"
+	"github.com/openshift/image-inspector/pkg/store"
+)
+
+// ResolveDigest returns the reference by digest of the manifest currently
+// behind the reference image in its registry, reached with the credentials
+// of opts. Nothing is pulled. The references by digest are returned as they
+// are.
+func ResolveDigest(opts iicmd.ImageInspectorOptions, image string) (string, error) {
+	if store.IsDigestReference(image) {
+		return image, nil
+	}
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		return "", err
+	}
+	inspector := &defaultImageInspector{opts: opts}
+	manifest, err := inspector.getManifest(ref)
+	if err != nil {
+		return "", fmt.Errorf("Unable to get the manifest of %s: %v\n", image, err)
+	}
+	return ref.WithDigest(manifest.Digest), nil
+}
+
+// repoDigest returns the digest reference of repoDigests in the repository
+// of image, empty when there is none. Both are compared once fully
+// qualified, docker.io/library/nginx being nginx.
+func repoDigest(image string, repoDigests []string) string {
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		return ""
+	}
+	for _, repoDigest := range repoDigests {
+		if !strings.Contains(repoDigest, "@") {
+			continue
+		}
+		if digestRef, err := registry.ParseReference(repoDigest); err == nil && digestRef.Name() == ref.Name() {
+			return repoDigest
+		}
+	}
+	return ""
+}
@@ -0,0 +1,41 @@
+package inspector
+
+import (
+	"fmt"
+	"log"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	"github.com/openshift/image-inspector/pkg/registry"
+	"github.com/openshift/image-inspector/pkg/signature"
+)
+
+// verifySignatures verifies the signatures of the option's image, pinned to
+// its digest, against the option's signature policy before it's pulled and
+// records the result. An image failing the verification is only inspected
+// when the signatures aren't required.
+func (i *defaultImageInspector) verifySignatures() error {
+	policy, err := signature.LoadPolicy(i.opts.SignaturePolicy)
+	if err != nil {
+		return err
+	}
+	ref, err := registry.ParseReference(i.opts.Image)
+	if err != nil {
+		return err
+	}
+	auth := docker.AuthConfiguration{}
+	if i.registryAuth != nil {
+		auth = *i.registryAuth
+	}
+	result := signature.NewVerifier(policy, newRegistryClient(auth)).Verify(ref)
+	i.meta.Signatures = result
+	if result.Verified {
+		log.Printf("The signatures of %s satisfy the signature policy", i.opts.Image)
+		return nil
+	}
+	if i.opts.RequireSignatures {
+		return fmt.Errorf("Unable to verify the signatures of %s: %s\n", i.opts.Image, result.Error)
+	}
+	log.Printf("WARNING: Unable to verify the signatures of %s, inspecting it anyway: %s", i.opts.Image, result.Error)
+	return nil
+}
@@ -0,0 +1,60 @@
+package inspector
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+)
+
+func TestVerifySignatures(t *testing.T) {
+	oldNewRegistryClient := newRegistryClient
+	defer func() { newRegistryClient = oldNewRegistryClient }()
+	newRegistryClient = func(docker.AuthConfiguration) registryClient { return &fakeRegistryClient{} }
+
+	dir, err := ioutil.TempDir("", "signature-policy-")
+	if err != nil {
+		t.Fatalf("Unable to create the temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	policy := path.Join(dir, "policy.json")
+	if err := ioutil.WriteFile(policy, []byte(`{"default": [{"type": "reject"}],
+		"scopes": {"docker.io/library/centos": [{"type": "insecureAcceptAnything"}]}}`), 0644); err != nil {
+		t.Fatalf("Unable to write the policy: %v", err)
+	}
+
+	for k, v := range map[string]struct {
+		image      string
+		require    bool
+		verified   bool
+		shouldFail bool
+	}{
+		"accepted image":            {image: "centos:7", require: true, verified: true},
+		"rejected image":            {image: "fedora:26"},
+		"rejected image required":   {image: "fedora:26", require: true, shouldFail: true},
+		"unresolved image required": {image: "centos:6", require: true, shouldFail: true},
+	} {
+		opts := iicmd.NewDefaultImageInspectorOptions()
+		opts.Image = v.image
+		opts.AllowUnresolvedTag = true
+		opts.SignaturePolicy = policy
+		opts.RequireSignatures = v.require
+		ii := &defaultImageInspector{opts: *opts}
+		if err := ii.resolveImage(v.image); err != nil {
+			t.Errorf("%s: unable to resolve the image: %v", k, err)
+			continue
+		}
+		err := ii.verifySignatures()
+		if v.shouldFail != (err != nil) {
+			t.Errorf("%s: unexpected error %v", k, err)
+			continue
+		}
+		if ii.meta.Signatures == nil || ii.meta.Signatures.Verified != v.verified {
+			t.Errorf("%s: unexpected verification %+v", k, ii.meta.Signatures)
+		}
+	}
+}
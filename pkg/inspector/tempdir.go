@@ -0,0 +1,133 @@
+package inspector
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const (
+	// TEMP_DIR_ROOT is where the temporary directories are created, /var/tmp
+	// is used because often it's not an in-memory tmpfs
+	TEMP_DIR_ROOT = "/var/tmp"
+	// TEMP_DIR_PREFIX is the prefix of all the temporary directories
+	TEMP_DIR_PREFIX = "image-inspector-"
+	// LOCK_FILE_SUFFIX is appended to the path of a temporary directory to
+	// get the path of its lock file
+	LOCK_FILE_SUFFIX = ".lock"
+)
+
+// TempDir is a temporary directory with a lock file next to it. The lock
+// file holds the PID of the process using the directory and stays locked
+// until the directory is released, or the process dies, so that the
+// directories left by crashed runs can be told apart.
+type TempDir struct {
+	Path string
+	lock *os.File
+}
+
+// NewTempDir creates a temporary directory in root, its name starting with
+// prefix, and locks it.
+func NewTempDir(root, prefix string) (*TempDir, error) {
+	dir, err := ioutilTempDir(root, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create temporary path: %v\n", err)
+	}
+	d := &TempDir{Path: dir}
+	if d.lock, err = createLockFile(d.LockPath()); err != nil {
+		os.RemoveAll(dir)
+		return nil, fmt.Errorf("Unable to lock temporary path %s: %v\n", dir, err)
+	}
+	return d, nil
+}
+
+// createLockFile creates the lock file lockPath holding the PID of this
+// process. The file is locked before getting its final name so that a
+// sweeper never sees it unlocked.
+func createLockFile(lockPath string) (*os.File, error) {
+	newPath := lockPath + ".new"
+	lock, err := os.OpenFile(newPath, os.O_RDWR|os.O_CREATE|os.O_EXCL, OWNER_PERM_RW)
+	if err != nil {
+		return nil, err
+	}
+	if err = syscall.Flock(int(lock.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err == nil {
+		if _, err = lock.WriteString(strconv.Itoa(os.Getpid()) + "\n"); err == nil {
+			err = os.Rename(newPath, lockPath)
+		}
+	}
+	if err != nil {
+		lock.Close()
+		os.Remove(newPath)
+		return nil, err
+	}
+	return lock, nil
+}
+
+// LockPath returns the path of the lock file of the directory.
+func (d *TempDir) LockPath() string {
+	return d.Path + LOCK_FILE_SUFFIX
+}
+
+// Release removes the directory, unless keep is true, and its lock file.
+// A kept directory has no lock file and is never swept.
+func (d *TempDir) Release(keep bool) error {
+	defer d.Unlock()
+	if !keep {
+		if err := os.RemoveAll(d.Path); err != nil {
+			return fmt.Errorf("Unable to remove %s: %v\n", d.Path, err)
+		}
+	}
+	if err := os.Remove(d.LockPath()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("Unable to remove %s: %v\n", d.LockPath(), err)
+	}
+	return nil
+}
+
+// Unlock releases the lock without removing anything, used once the
+// directory and its lock file have been removed otherwise.
+func (d *TempDir) Unlock() {
+	if d.lock != nil {
+		d.lock.Close()
+		d.lock = nil
+	}
+}
+
+// SweepStaleTempDirs removes the temporary directories in root whose lock
+// file isn't locked anymore, left by the runs that didn't release them. It
+// returns the removed directories. The directories without a lock file are
+// left alone.
+func SweepStaleTempDirs(root string) []string {
+	locks, err := filepath.Glob(filepath.Join(root, TEMP_DIR_PREFIX+"*"+LOCK_FILE_SUFFIX))
+	if err != nil {
+		log.Printf("WARNING: Unable to look for stale temporary directories: %v", err)
+		return nil
+	}
+	removed := []string{}
+	for _, lockPath := range locks {
+		lock, err := os.OpenFile(lockPath, os.O_RDWR, 0)
+		if err != nil {
+			continue
+		}
+		if err := syscall.Flock(int(lock.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+			// still in use
+			lock.Close()
+			continue
+		}
+		dir := strings.TrimSuffix(lockPath, LOCK_FILE_SUFFIX)
+		pid, _ := ioutil.ReadAll(lock)
+		log.Printf("Removing stale temporary directory %s of process %s", dir, strings.TrimSpace(string(pid)))
+		if err := os.RemoveAll(dir); err != nil {
+			log.Printf("WARNING: Unable to remove %s: %v", dir, err)
+		} else {
+			os.Remove(lockPath)
+			removed = append(removed, dir)
+		}
+		lock.Close()
+	}
+	return removed
+}
@@ -0,0 +1,63 @@
+package inspector
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTempDirSweep(t *testing.T) {
+	root, err := ioutil.TempDir("", "tempdir-test-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	inUse, err := NewTempDir(root, TEMP_DIR_PREFIX)
+	if err != nil {
+		t.Fatalf("unexpected error creating a temporary directory: %v", err)
+	}
+	defer inUse.Release(false)
+	crashed, err := NewTempDir(root, TEMP_DIR_PREFIX+"scan-results-")
+	if err != nil {
+		t.Fatalf("unexpected error creating a temporary directory: %v", err)
+	}
+	// a crashed run leaves its directory and lock file but not the lock
+	crashed.Unlock()
+	kept, err := NewTempDir(root, TEMP_DIR_PREFIX)
+	if err != nil {
+		t.Fatalf("unexpected error creating a temporary directory: %v", err)
+	}
+	if err := kept.Release(true); err != nil {
+		t.Fatalf("unexpected error keeping a temporary directory: %v", err)
+	}
+	unlocked := filepath.Join(root, TEMP_DIR_PREFIX+"unlocked")
+	if err := os.Mkdir(unlocked, 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	removed := SweepStaleTempDirs(root)
+	if len(removed) != 1 || removed[0] != crashed.Path {
+		t.Errorf("only %s should have been swept, got %v", crashed.Path, removed)
+	}
+	for _, gone := range []string{crashed.Path, crashed.LockPath(), kept.LockPath()} {
+		if _, err := os.Lstat(gone); !os.IsNotExist(err) {
+			t.Errorf("%s should not exist", gone)
+		}
+	}
+	for _, exists := range []string{inUse.Path, inUse.LockPath(), kept.Path, unlocked} {
+		if _, err := os.Lstat(exists); err != nil {
+			t.Errorf("%s should exist: %v", exists, err)
+		}
+	}
+
+	if err := inUse.Release(false); err != nil {
+		t.Errorf("unexpected error releasing a temporary directory: %v", err)
+	}
+	for _, gone := range []string{inUse.Path, inUse.LockPath()} {
+		if _, err := os.Lstat(gone); !os.IsNotExist(err) {
+			t.Errorf("%s should have been removed", gone)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package kube
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"time"
+)
+
+const (
+	SERVICE_ACCOUNT_DIR    = "/var/run/secrets/kubernetes.io/serviceaccount"
+	serviceAccountTokenKey = "token"
+	serviceAccountCAKey    = "ca.crt"
+	// MERGE_PATCH is the content type of the JSON merge patches
+	MERGE_PATCH = "application/merge-patch+json"
+)
+
+// StatusError is the error returned by the API server for a failed request.
+type StatusError struct {
+	Code    int
+	Message string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("Kubernetes API returned %d: %s", e.Code, e.Message)
+}
+
+// IsStatus returns true if err is a StatusError with code.
+func IsStatus(err error, code int) bool {
+	statusErr, ok := err.(*StatusError)
+	return ok && statusErr.Code == code
+}
+
+// Client sends requests to a Kubernetes API server.
+type Client struct {
+	// Host is the URL of the API server
+	Host   string
+	token  string
+	client *http.Client
+}
+
+// NewClient returns a client of the API server at host, authenticating with
+// the bearer token when not empty. The requests time out after timeout.
+func NewClient(host, token string, transport http.RoundTripper, timeout time.Duration) *Client {
+	return &Client{
+		Host:   host,
+		token:  token,
+		client: &http.Client{Timeout: timeout, Transport: transport},
+	}
+}
+
+// NewInClusterClient returns a client of the API server of the cluster the
+// pod runs in, with the credentials of the pod service account.
+func NewInClusterClient(timeout time.Duration) (*Client, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if len(host) == 0 || len(port) == 0 {
+		return nil, fmt.Errorf("Unable to reach the Kubernetes API outside of a Kubernetes cluster")
+	}
+	token, err := ioutil.ReadFile(SERVICE_ACCOUNT_DIR + "/" + serviceAccountTokenKey)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read the service account token: %v\n", err)
+	}
+	ca, err := ioutil.ReadFile(SERVICE_ACCOUNT_DIR + "/" + serviceAccountCAKey)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read the service account CA: %v\n", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("Unable to parse the service account CA")
+	}
+	return NewClient("https://"+net.JoinHostPort(host, port), string(bytes.TrimSpace(token)),
+		&http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}, timeout), nil
+}
+
+// Do sends a request to path with body encoded as JSON, when not nil, with
+// contentType, application/json when empty, and decodes the response into
+// out, when not nil.
+func (c *Client) Do(method, path, contentType string, body, out interface{}) error {
+	var content io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		content = bytes.NewReader(encoded)
+	}
+	resp, err := c.send(method, path, contentType, content)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("Unable to decode the response of %s %s: %v", method, path, err)
+	}
+	return nil
+}
+
+// send sends a request and turns the error responses into a
+// StatusError.
+func (c *Client) send(method, path, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, c.Host+path, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		if len(contentType) == 0 {
+			contentType = "application/json"
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", "application/json")
+	if len(c.token) > 0 {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		var status Status
+		message, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		if err := json.Unmarshal(message, &status); err == nil && len(status.Message) > 0 {
+			return nil, &StatusError{Code: resp.StatusCode, Message: status.Message}
+		}
+		return nil, &StatusError{Code: resp.StatusCode, Message: string(bytes.TrimSpace(message))}
+	}
+	return resp, nil
+}
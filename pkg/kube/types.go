@@ -0,0 +1,62 @@
+package kube
+
+// ObjectMeta is the subset of the metadata of a Kubernetes object used by
+// image-inspector.
+type ObjectMeta struct {
+	Name         string            `json:"name,omitempty"`
+	GenerateName string            `json:"generateName,omitempty"`
+	Namespace    string            `json:"namespace,omitempty"`
+	UID          string            `json:"uid,omitempty"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+}
+
+// Status describes the outcome of a failed request.
+type Status struct {
+	Kind    string `json:"kind,omitempty"`
+	Code    int    `json:"code,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Pod is the subset of a Kubernetes Pod used by image-inspector.
+type Pod struct {
+	APIVersion string     `json:"apiVersion,omitempty"`
+	Kind       string     `json:"kind,omitempty"`
+	Metadata   ObjectMeta `json:"metadata"`
+	Spec       PodSpec    `json:"spec"`
+}
+
+// PodSpec lists the containers of a Pod.
+type PodSpec struct {
+	InitContainers      []Container `json:"initContainers,omitempty"`
+	Containers          []Container `json:"containers"`
+	EphemeralContainers []Container `json:"ephemeralContainers,omitempty"`
+}
+
+// Container is a container of a Pod and the image it runs.
+type Container struct {
+	Name  string `json:"name"`
+	Image string `json:"image"`
+}
+
+// AllContainers returns the init, regular and ephemeral containers of the
+// pod, in this order.
+func (s *PodSpec) AllContainers() []Container {
+	containers := make([]Container, 0, len(s.InitContainers)+len(s.Containers)+len(s.EphemeralContainers))
+	containers = append(containers, s.InitContainers...)
+	containers = append(containers, s.Containers...)
+	return append(containers, s.EphemeralContainers...)
+}
+
+// DisplayName returns the name of the object, or its generated name prefix
+// when it isn't named yet, with its namespace.
+func (m *ObjectMeta) DisplayName() string {
+	name := m.Name
+	if len(name) == 0 {
+		name = m.GenerateName + "*"
+	}
+	if len(m.Namespace) == 0 {
+		return name
+	}
+	return m.Namespace + "/" + name
+}
@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+const (
+	StagePull    = "pull"
+	StageExtract = "extract"
+	StageScan    = "scan"
+
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// StageBuckets are the histogram buckets of the inspection stage durations
+// in seconds.
+var StageBuckets = []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 1800, 3600}
+
+var (
+	// DefaultRegistry holds the metrics of image-inspector.
+	DefaultRegistry = NewRegistry()
+
+	StageDuration = DefaultRegistry.NewHistogram("image_inspector_stage_duration_seconds",
+		"Duration of the stages of the inspections.", StageBuckets, "stage")
+	StageTotal = DefaultRegistry.NewCounter("image_inspector_stage_total",
+		"Number of stages of the inspections run, by result.", "stage", "result")
+	DownloadedBytes = DefaultRegistry.NewCounter("image_inspector_downloaded_bytes_total",
+		"Bytes downloaded pulling images.")
+	Findings = DefaultRegistry.NewGauge("image_inspector_findings",
+		"Findings of the last inspection, by severity and result.", "severity", "result")
+	HTTPRequests = DefaultRegistry.NewCounter("image_inspector_http_requests_total",
+		"HTTP requests served, by handler, method and status code.", "handler", "method", "code")
+	HTTPRequestDuration = DefaultRegistry.NewHistogram("image_inspector_http_request_duration_seconds",
+		"Duration of the HTTP requests served, by handler and method.", DefBuckets, "handler", "method")
+)
+
+// ObserveStage records the outcome of an inspection stage that started at
+// start and failed if err is not nil.
+func ObserveStage(stage string, start time.Time, err error) {
+	StageDuration.Observe(time.Since(start).Seconds(), stage)
+	result := ResultSuccess
+	if err != nil {
+		result = ResultFailure
+	}
+	StageTotal.Inc(stage, result)
+}
+
+// SetFindings replaces the findings gauges with the counts of findings.
+func SetFindings(findings []iiapi.Finding) {
+	counts := map[[2]string]int{}
+	for _, finding := range findings {
+		severity := strings.ToLower(finding.Severity)
+		if len(severity) == 0 {
+			severity = "unknown"
+		}
+		counts[[2]string{severity, string(finding.Result)}]++
+	}
+	Findings.Reset()
+	for labels, count := range counts {
+		Findings.Set(float64(count), labels[0], labels[1])
+	}
+}
+
+// InstrumentHandler records the HTTP metrics of the requests served by mux,
+// labelled by the pattern of the handler serving them.
+func InstrumentHandler(mux *http.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		_, pattern := mux.Handler(r)
+		if len(pattern) == 0 {
+			pattern = "none"
+		}
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		mux.ServeHTTP(sw, r)
+		HTTPRequests.Inc(pattern, r.Method, strconv.Itoa(sw.status))
+		HTTPRequestDuration.Observe(time.Since(start).Seconds(), pattern, r.Method)
+	})
+}
+
+// statusWriter records the status code of a response.
+type statusWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,250 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	// CONTENT_TYPE is the content type of the Prometheus text format
+	CONTENT_TYPE = "text/plain; version=0.0.4; charset=utf-8"
+
+	counterType   = "counter"
+	gaugeType     = "gauge"
+	histogramType = "histogram"
+)
+
+// DefBuckets are the default histogram buckets, fit for request durations
+// in seconds.
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Registry holds a set of metrics and exposes them in the Prometheus text
+// format.
+type Registry struct {
+	lock     sync.Mutex
+	families []*family
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// family is a metric with all its labelled series.
+type family struct {
+	name       string
+	help       string
+	kind       string
+	labelNames []string
+	// buckets are the upper bounds of the histogram buckets
+	buckets []float64
+
+	lock   sync.Mutex
+	series map[string]*series
+}
+
+// series is a metric with a given set of label values.
+type series struct {
+	labelValues []string
+	value       float64
+	// counts are the cumulative bucket counts of a histogram
+	counts []uint64
+	count  uint64
+}
+
+func (r *Registry) register(f *family) *family {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, registered := range r.families {
+		if registered.name == f.name {
+			panic(fmt.Sprintf("metric %s registered twice", f.name))
+		}
+	}
+	f.series = map[string]*series{}
+	r.families = append(r.families, f)
+	return f
+}
+
+// with runs fn on the series of labelValues, creating it if needed.
+func (f *family) with(labelValues []string, fn func(s *series)) {
+	if len(labelValues) != len(f.labelNames) {
+		panic(fmt.Sprintf("metric %s expects labels %v, got %v", f.name, f.labelNames, labelValues))
+	}
+	key := strings.Join(labelValues, "\xff")
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	s, ok := f.series[key]
+	if !ok {
+		s = &series{labelValues: append([]string{}, labelValues...)}
+		if f.kind == histogramType {
+			s.counts = make([]uint64, len(f.buckets))
+		}
+		f.series[key] = s
+	}
+	fn(s)
+}
+
+// Counter is a metric that only goes up.
+type Counter struct {
+	f *family
+}
+
+// NewCounter registers a counter with the given label names.
+func (r *Registry) NewCounter(name, help string, labelNames ...string) *Counter {
+	return &Counter{r.register(&family{name: name, help: help, kind: counterType, labelNames: labelNames})}
+}
+
+// Add adds v, which must not be negative, to the series of labelValues.
+func (c *Counter) Add(v float64, labelValues ...string) {
+	if v < 0 {
+		panic(fmt.Sprintf("counter %s can't decrease", c.f.name))
+	}
+	c.f.with(labelValues, func(s *series) { s.value += v })
+}
+
+// Inc increments the series of labelValues.
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Gauge is a metric that can go up and down.
+type Gauge struct {
+	f *family
+}
+
+// NewGauge registers a gauge with the given label names.
+func (r *Registry) NewGauge(name, help string, labelNames ...string) *Gauge {
+	return &Gauge{r.register(&family{name: name, help: help, kind: gaugeType, labelNames: labelNames})}
+}
+
+// Set sets the series of labelValues to v.
+func (g *Gauge) Set(v float64, labelValues ...string) {
+	g.f.with(labelValues, func(s *series) { s.value = v })
+}
+
+// Add adds v to the series of labelValues.
+func (g *Gauge) Add(v float64, labelValues ...string) {
+	g.f.with(labelValues, func(s *series) { s.value += v })
+}
+
+// Reset removes all the series of the gauge.
+func (g *Gauge) Reset() {
+	g.f.lock.Lock()
+	defer g.f.lock.Unlock()
+	g.f.series = map[string]*series{}
+}
+
+// Histogram counts the observed values in buckets.
+type Histogram struct {
+	f *family
+}
+
+// NewHistogram registers a histogram with the given buckets, sorted upper
+// bounds, and label names.
+func (r *Registry) NewHistogram(name, help string, buckets []float64, labelNames ...string) *Histogram {
+	return &Histogram{r.register(&family{name: name, help: help, kind: histogramType,
+		labelNames: labelNames, buckets: buckets})}
+}
+
+// Observe records v in the series of labelValues.
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	h.f.with(labelValues, func(s *series) {
+		for idx, bound := range h.f.buckets {
+			if v <= bound {
+				s.counts[idx]++
+			}
+		}
+		s.count++
+		s.value += v
+	})
+}
+
+// Write writes all the metrics of the registry in the Prometheus text
+// format.
+func (r *Registry) Write(w io.Writer) error {
+	r.lock.Lock()
+	families := append([]*family{}, r.families...)
+	r.lock.Unlock()
+
+	bw := bufio.NewWriter(w)
+	for _, f := range families {
+		f.write(bw)
+	}
+	return bw.Flush()
+}
+
+// ServeHTTP serves the metrics of the registry.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", CONTENT_TYPE)
+	r.Write(w)
+}
+
+func (f *family) write(w io.Writer) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", f.name, f.kind)
+	keys := make([]string, 0, len(f.series))
+	for key := range f.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		s := f.series[key]
+		if f.kind != histogramType {
+			fmt.Fprintf(w, "%s%s %s\n", f.name, formatLabels(f.labelNames, s.labelValues), formatValue(s.value))
+			continue
+		}
+		names := append(append([]string{}, f.labelNames...), "le")
+		for idx, bound := range f.buckets {
+			values := append(append([]string{}, s.labelValues...), formatValue(bound))
+			fmt.Fprintf(w, "%s_bucket%s %d\n", f.name, formatLabels(names, values), s.counts[idx])
+		}
+		values := append(append([]string{}, s.labelValues...), "+Inf")
+		fmt.Fprintf(w, "%s_bucket%s %d\n", f.name, formatLabels(names, values), s.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", f.name, formatLabels(f.labelNames, s.labelValues), formatValue(s.value))
+		fmt.Fprintf(w, "%s_count%s %d\n", f.name, formatLabels(f.labelNames, s.labelValues), s.count)
+	}
+}
+
+func formatLabels(names, values []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	pairs := make([]string, len(names))
+	for idx := range names {
+		pairs[idx] = fmt.Sprintf("%s=\"%s\"", names[idx], escapeLabelValue(values[idx]))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func escapeLabelValue(s string) string {
+	return labelEscaper.Replace(s)
+}
@@ -0,0 +1,126 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+func TestRegistryWrite(t *testing.T) {
+	r := NewRegistry()
+	counter := r.NewCounter("test_total", "A counter.", "kind")
+	gauge := r.NewGauge("test_gauge", "A gauge\nwith \\ escapes.")
+	histogram := r.NewHistogram("test_seconds", "A histogram.", []float64{1, 5}, "stage")
+
+	counter.Inc("b")
+	counter.Add(2.5, "a\"quoted\"")
+	gauge.Set(3)
+	gauge.Add(-1)
+	histogram.Observe(0.5, "pull")
+	histogram.Observe(3, "pull")
+	histogram.Observe(10, "pull")
+
+	buf := &bytes.Buffer{}
+	if err := r.Write(buf); err != nil {
+		t.Fatalf("Unable to write the metrics: %v", err)
+	}
+	expected := `# HELP test_total A counter.
+# TYPE test_total counter
+test_total{kind="a\"quoted\""} 2.5
+test_total{kind="b"} 1
+# HELP test_gauge A gauge\nwith \\ escapes.
+# TYPE test_gauge gauge
+test_gauge 2
+# HELP test_seconds A histogram.
+# TYPE test_seconds histogram
+test_seconds_bucket{stage="pull",le="1"} 1
+test_seconds_bucket{stage="pull",le="5"} 2
+test_seconds_bucket{stage="pull",le="+Inf"} 3
+test_seconds_sum{stage="pull"} 13.5
+test_seconds_count{stage="pull"} 3
+`
+	if buf.String() != expected {
+		t.Errorf("Expected metrics:\n%s\nbut got:\n%s", expected, buf.String())
+	}
+
+	gauge.Reset()
+	buf.Reset()
+	r.Write(buf)
+	if strings.Contains(buf.String(), "test_gauge 2") {
+		t.Errorf("The gauge should have been reset:\n%s", buf.String())
+	}
+}
+
+func TestRegistryMisuse(t *testing.T) {
+	for k, fn := range map[string]func(r *Registry){
+		"registered twice": func(r *Registry) {
+			r.NewCounter("twice", "")
+			r.NewGauge("twice", "")
+		},
+		"wrong labels":       func(r *Registry) { r.NewCounter("labels", "", "a").Inc() },
+		"decreasing counter": func(r *Registry) { r.NewCounter("decreasing", "").Add(-1) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s should have failed", k)
+				}
+			}()
+			fn(NewRegistry())
+		}()
+	}
+}
+
+func TestInspectorMetrics(t *testing.T) {
+	ObserveStage(StagePull, time.Now(), nil)
+	ObserveStage(StageScan, time.Now(), fmt.Errorf("FAIL!"))
+	SetFindings([]iiapi.Finding{
+		{Severity: "High", Result: iiapi.ResultFail},
+		{Severity: "high", Result: iiapi.ResultFail},
+		{Severity: "low", Result: iiapi.ResultPass},
+		{Result: iiapi.ResultNotChecked},
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", DefaultRegistry)
+	mux.HandleFunc("/teapot", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+	server := httptest.NewServer(InstrumentHandler(mux))
+	defer server.Close()
+
+	if _, err := http.Get(server.URL + "/teapot"); err != nil {
+		t.Fatalf("Unable to get /teapot: %v", err)
+	}
+	resp, err := http.Get(server.URL + "/metrics")
+	if err != nil {
+		t.Fatalf("Unable to get the metrics: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Type") != CONTENT_TYPE {
+		t.Errorf("Unexpected content type %s", resp.Header.Get("Content-Type"))
+	}
+	buf := &bytes.Buffer{}
+	buf.ReadFrom(resp.Body)
+
+	for _, line := range []string{
+		`image_inspector_stage_total{stage="pull",result="success"} 1`,
+		`image_inspector_stage_total{stage="scan",result="failure"} 1`,
+		`image_inspector_stage_duration_seconds_count{stage="pull"} 1`,
+		`image_inspector_findings{severity="high",result="fail"} 2`,
+		`image_inspector_findings{severity="low",result="pass"} 1`,
+		`image_inspector_findings{severity="unknown",result="notchecked"} 1`,
+		`image_inspector_http_requests_total{handler="/teapot",method="GET",code="418"} 1`,
+		`image_inspector_http_request_duration_seconds_count{handler="/teapot",method="GET"} 1`,
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Errorf("The metrics should contain %s:\n%s", line, buf.String())
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"path"
 	"strings"
+	"sync"
 	"syscall"
 
 	docker "github.com/fsouza/go-dockerclient"
@@ -33,6 +34,17 @@ const (
 var (
 	RHELDistNumbers = [...]int{5, 6, 7}
 	osSetEnv        = os.Setenv
+	// oscapLock serializes the oscap executions since their configuration is
+	// passed through the process environment.
+	oscapLock sync.Mutex
+	// probeArchitectures maps the image architectures, named as GOARCH, to
+	// the machine names of uname the OpenSCAP probes expect
+	probeArchitectures = map[string]string{
+		"amd64": "x86_64",
+		"386":   "i686",
+		"arm64": "aarch64",
+		"arm":   "armv7l",
+	}
 )
 
 // rhelDistFunc provides an injectable way to get the rhel dist for testing.
@@ -135,7 +147,7 @@ func (s *defaultOSCAPScanner) setOscapChrootEnv() error {
 	for k, v := range map[string]string{
 		"OSCAP_PROBE_ROOT":         s.imageMountPath,
 		"OSCAP_PROBE_OS_VERSION":   LinuxVersionPH, // FIXME place holder value
-		"OSCAP_PROBE_ARCHITECTURE": util.StrOrDefault(s.image.Architecture, Unknown),
+		"OSCAP_PROBE_ARCHITECTURE": probeArchitecture(s.image.Architecture),
 		"OSCAP_PROBE_OS_NAME":      Linux,
 		"OSCAP_PROBE_PRIMARY_HOST_NAME": fmt.Sprintf("docker-image-%s",
 			s.image.ID[:util.Min(ImageShortIDLen, len(s.image.ID))]),
@@ -148,8 +160,18 @@ func (s *defaultOSCAPScanner) setOscapChrootEnv() error {
 	return nil
 }
 
+// probeArchitecture returns the uname machine name of the image architecture arch.
+func probeArchitecture(arch string) string {
+	if machine, ok := probeArchitectures[arch]; ok {
+		return machine
+	}
+	return util.StrOrDefault(arch, Unknown)
+}
+
 // Wrapper function for executing oscap
 func (s *defaultOSCAPScanner) oscapChroot(oscapArgs ...string) ([]byte, error) {
+	oscapLock.Lock()
+	defer oscapLock.Unlock()
 	if err := s.setEnv(); err != nil {
 		return nil, fmt.Errorf("Unable to set env variables in oscapChroot: %v", err)
 	}
@@ -201,3 +201,18 @@ func TestSetOscapChrootEnv(t *testing.T) {
 		}
 	}
 }
+
+func TestProbeArchitecture(t *testing.T) {
+	for arch, expected := range map[string]string{
+		"amd64":   "x86_64",
+		"arm64":   "aarch64",
+		"ppc64le": "ppc64le",
+		"s390x":   "s390x",
+		"x86_64":  "x86_64",
+		"":        Unknown,
+	} {
+		if machine := probeArchitecture(arch); machine != expected {
+			t.Errorf("Expected %s to be probed as %s but got %s", arch, expected, machine)
+		}
+	}
+}
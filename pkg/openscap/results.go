@@ -0,0 +1,94 @@
+package openscap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	util "github.com/openshift/image-inspector/pkg/util"
+)
+
+// xccdfIdent is an identifier (e.g. a CVE) attached to a rule.
+type xccdfIdent struct {
+	System string `xml:"system,attr"`
+	Value  string `xml:",chardata"`
+}
+
+// xccdfRule is a rule of the XCCDF benchmark.
+type xccdfRule struct {
+	ID       string       `xml:"id,attr"`
+	Severity string       `xml:"severity,attr"`
+	Title    string       `xml:"title"`
+	Idents   []xccdfIdent `xml:"ident"`
+}
+
+// xccdfRuleResult is the result of a rule in the XCCDF TestResult.
+type xccdfRuleResult struct {
+	IDRef    string       `xml:"idref,attr"`
+	Severity string       `xml:"severity,attr"`
+	Time     string       `xml:"time,attr"`
+	Result   string       `xml:"result"`
+	Idents   []xccdfIdent `xml:"ident"`
+}
+
+// ParseResults parses the ARF report produced by the scanner into a finding
+// for every rule result, in the order they appear in the report.
+func ParseResults(reader io.Reader) ([]iiapi.Finding, error) {
+	rules := map[string]xccdfRule{}
+	results := []xccdfRuleResult{}
+
+	dec := xml.NewDecoder(reader)
+	for {
+		token, err := dec.Token()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("Unable to parse the %s results: %v", OpenSCAP, err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "Rule":
+			var rule xccdfRule
+			if err := dec.DecodeElement(&rule, &start); err != nil {
+				return nil, fmt.Errorf("Unable to parse the %s rule: %v", OpenSCAP, err)
+			}
+			rules[rule.ID] = rule
+		case "rule-result":
+			var result xccdfRuleResult
+			if err := dec.DecodeElement(&result, &start); err != nil {
+				return nil, fmt.Errorf("Unable to parse the %s rule result: %v", OpenSCAP, err)
+			}
+			results = append(results, result)
+		}
+	}
+
+	findings := make([]iiapi.Finding, 0, len(results))
+	for _, result := range results {
+		rule := rules[result.IDRef]
+		finding := iiapi.Finding{
+			Scanner:    OpenSCAP,
+			ID:         result.IDRef,
+			Title:      strings.TrimSpace(rule.Title),
+			Severity:   util.StrOrDefault(result.Severity, rule.Severity),
+			Result:     iiapi.FindingResult(strings.TrimSpace(result.Result)),
+			References: []string{},
+			Time:       result.Time,
+		}
+		seen := map[string]bool{}
+		for _, ident := range append(result.Idents, rule.Idents...) {
+			value := strings.TrimSpace(ident.Value)
+			if len(value) > 0 && !seen[value] {
+				seen[value] = true
+				finding.References = append(finding.References, value)
+			}
+		}
+		findings = append(findings, finding)
+	}
+	return findings, nil
+}
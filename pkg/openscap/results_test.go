@@ -0,0 +1,51 @@
+package openscap
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+func TestParseResults(t *testing.T) {
+	file, err := os.Open("test/results-arf.xml")
+	if err != nil {
+		t.Fatalf("Unable to open the ARF fixture: %v", err)
+	}
+	defer file.Close()
+
+	findings, err := ParseResults(file)
+	if err != nil {
+		t.Fatalf("Unable to parse the ARF fixture: %v", err)
+	}
+	if len(findings) != 3 {
+		t.Fatalf("Expected 3 findings but got %d", len(findings))
+	}
+
+	for idx, expected := range []struct {
+		result     iiapi.FindingResult
+		severity   string
+		title      string
+		references []string
+	}{
+		{iiapi.ResultFail, "high", "RHSA-2016:0176: glibc security and bug fix update (Critical)",
+			[]string{"CVE-2015-7547", "CVE-2015-5229"}},
+		{iiapi.ResultPass, "medium", "RHSA-2016:0073: bind security update (Important)",
+			[]string{"CVE-2015-8704"}},
+		{iiapi.ResultNotApplicable, "low", "RHSA-2015:0001: thing update (Low)", []string{}},
+	} {
+		finding := findings[idx]
+		if finding.Scanner != OpenSCAP || finding.Result != expected.result ||
+			finding.Severity != expected.severity || finding.Title != expected.title {
+			t.Errorf("Unexpected finding %d: %+v", idx, finding)
+		}
+		if strings.Join(finding.References, ",") != strings.Join(expected.references, ",") {
+			t.Errorf("Unexpected references for finding %d: %v", idx, finding.References)
+		}
+	}
+
+	if _, err := ParseResults(strings.NewReader("<TestResult><rule-result>")); err == nil {
+		t.Errorf("a truncated report should have failed")
+	}
+}
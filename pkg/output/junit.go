@@ -0,0 +1,129 @@
+package output
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	util "github.com/openshift/image-inspector/pkg/util"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Details string `xml:",chardata"`
+}
+
+func renderJUnit(w io.Writer, report *iiapi.InspectorReport) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(newJUnitTestSuites(report)); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// newJUnitTestSuites converts the report findings to JUnit test cases grouped
+// in a test suite for each scanner.
+func newJUnitTestSuites(report *iiapi.InspectorReport) *junitTestSuites {
+	suites := &junitTestSuites{
+		Name:   report.Image,
+		Suites: []junitTestSuite{},
+	}
+	indexes := map[string]int{}
+	for _, finding := range report.Findings {
+		idx, ok := indexes[finding.Scanner]
+		if !ok {
+			idx = len(suites.Suites)
+			indexes[finding.Scanner] = idx
+			suites.Suites = append(suites.Suites, junitTestSuite{Name: finding.Scanner})
+		}
+		suite := &suites.Suites[idx]
+
+		testCase := junitTestCase{
+			Name:      finding.ID,
+			ClassName: finding.Scanner,
+		}
+		if len(finding.Title) > 0 {
+			testCase.Name = fmt.Sprintf("%s: %s", finding.ID, oneLine(finding.Title))
+		}
+		message := &junitMessage{
+			Message: oneLine(util.StrOrDefault(finding.Title, finding.ID)),
+			Type:    finding.Severity,
+			Details: junitDetails(finding),
+		}
+		switch finding.Result {
+		case iiapi.ResultPass, iiapi.ResultFixed, iiapi.ResultInformational:
+		case iiapi.ResultFail:
+			testCase.Failure = message
+			suite.Failures++
+		case iiapi.ResultNotApplicable, iiapi.ResultNotSelected, iiapi.ResultNotChecked:
+			testCase.Skipped = &junitMessage{Message: string(finding.Result)}
+			suite.Skipped++
+		default:
+			testCase.Error = message
+			suite.Errors++
+		}
+		suite.Tests++
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	for _, suite := range suites.Suites {
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+	}
+	return suites
+}
+
+// junitDetails describes a finding in the body of a failure or an error.
+func junitDetails(finding iiapi.Finding) string {
+	details := []string{
+		fmt.Sprintf("Result: %s", finding.Result),
+		fmt.Sprintf("Severity: %s", finding.Severity),
+	}
+	if len(finding.References) > 0 {
+		details = append(details, fmt.Sprintf("References: %s", strings.Join(finding.References, ", ")))
+	}
+	if len(finding.Path) > 0 {
+		details = append(details, fmt.Sprintf("Path: %s", finding.Path))
+	}
+	if finding.Layer != nil {
+		details = append(details, fmt.Sprintf("Layer: %s (%s)", finding.Layer.Digest, oneLine(finding.Layer.CreatedBy)))
+	}
+	return strings.Join(details, "\n")
+}
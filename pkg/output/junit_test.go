@@ -0,0 +1,54 @@
+package output
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+func TestRenderJUnit(t *testing.T) {
+	report := testReport()
+	report.Image = "fedora:22"
+	report.Findings = append(report.Findings,
+		iiapi.Finding{Scanner: "OpenSCAP", ID: "rule-3", Title: "RHSA-2015:0001", Result: iiapi.ResultNotApplicable},
+		iiapi.Finding{Scanner: "OpenSCAP", ID: "rule-4", Result: iiapi.ResultError},
+		iiapi.Finding{Scanner: "secrets", ID: "private-key", Result: iiapi.ResultPass})
+
+	buf := &bytes.Buffer{}
+	if err := Render(buf, "junit", report); err != nil {
+		t.Fatalf("Unable to render junit: %v", err)
+	}
+	if !strings.HasPrefix(buf.String(), xml.Header) {
+		t.Errorf("The junit output doesn't start with the XML header")
+	}
+	var suites junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &suites); err != nil {
+		t.Fatalf("The junit output can't be parsed: %v", err)
+	}
+
+	if suites.Name != "fedora:22" || suites.Tests != 5 || suites.Failures != 1 ||
+		suites.Errors != 1 || suites.Skipped != 1 || len(suites.Suites) != 2 {
+		t.Fatalf("Unexpected test suites %s", buf.String())
+	}
+	oscap := suites.Suites[0]
+	if oscap.Name != "OpenSCAP" || oscap.Tests != 4 || len(oscap.TestCases) != 4 {
+		t.Errorf("Unexpected OpenSCAP test suite %+v", oscap)
+	}
+	failed := oscap.TestCases[0]
+	if failed.Failure == nil || failed.Failure.Type != "high" ||
+		!strings.Contains(failed.Failure.Details, "CVE-2015-7547") {
+		t.Errorf("Unexpected failed test case %+v", failed)
+	}
+	if oscap.TestCases[1].Failure != nil || oscap.TestCases[1].Skipped != nil {
+		t.Errorf("The passed test case should have neither failures nor skips %+v", oscap.TestCases[1])
+	}
+	if oscap.TestCases[2].Skipped == nil || oscap.TestCases[2].Skipped.Message != "notapplicable" {
+		t.Errorf("Unexpected skipped test case %+v", oscap.TestCases[2])
+	}
+	if oscap.TestCases[3].Error == nil || oscap.TestCases[3].Name != "rule-4" {
+		t.Errorf("Unexpected errored test case %+v", oscap.TestCases[3])
+	}
+}
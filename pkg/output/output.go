@@ -0,0 +1,39 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+// renderFunc renders a report to a writer.
+type renderFunc func(io.Writer, *iiapi.InspectorReport) error
+
+var renderers = map[string]renderFunc{
+	"json":  renderJSON,
+	"yaml":  renderYAML,
+	"table": renderTable,
+	"sarif": renderSARIF,
+	"junit": renderJUnit,
+}
+
+// Render writes report to w in the given format, one of iiapi.OutputOptions.
+func Render(w io.Writer, format string, report *iiapi.InspectorReport) error {
+	render, ok := renderers[format]
+	if !ok {
+		return fmt.Errorf("%s is not one of the available outputs which are %v",
+			format, iiapi.OutputOptions)
+	}
+	return render(w, report)
+}
+
+func renderJSON(w io.Writer, report *iiapi.InspectorReport) error {
+	body, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(body, '\n'))
+	return err
+}
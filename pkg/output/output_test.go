@@ -0,0 +1,131 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+func testReport() *iiapi.InspectorReport {
+	return &iiapi.InspectorReport{
+		Metadata: &iiapi.InspectorMetadata{
+			Image: docker.Image{
+				ID:       "sha256:1234",
+				RepoTags: []string{"fedora:22"},
+			},
+			OpenSCAP: &iiapi.OpenSCAPMetadata{Status: iiapi.StatusSuccess},
+			Layers: []iiapi.ImageLayer{
+				{Digest: "sha256:abcd", CreatedBy: "/bin/sh -c #(nop) ADD file:1234 in /", Size: 42, Files: 3},
+			},
+		},
+		Findings: []iiapi.Finding{
+			{Scanner: "OpenSCAP", ID: "rule-1", Title: "RHSA-2016:0176: glibc (Critical)",
+				Severity: "high", Result: iiapi.ResultFail, References: []string{"CVE-2015-7547"}},
+			{Scanner: "OpenSCAP", ID: "rule-2", Title: "RHSA-2016:0073: bind",
+				Severity: "medium", Result: iiapi.ResultPass, References: []string{}},
+		},
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := Render(buf, "json", testReport()); err != nil {
+		t.Fatalf("Unable to render json: %v", err)
+	}
+	var report iiapi.InspectorReport
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("The json output can't be parsed: %v", err)
+	}
+	if report.Metadata.ID != "sha256:1234" || len(report.Findings) != 2 {
+		t.Errorf("Unexpected json output %s", buf.String())
+	}
+}
+
+func TestRenderYAML(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := Render(buf, "yaml", testReport()); err != nil {
+		t.Fatalf("Unable to render yaml: %v", err)
+	}
+	out := buf.String()
+	for _, expected := range []string{
+		"Metadata:\n  Id: \"sha256:1234\"\n  RepoTags:\n    - \"fedora:22\"\n",
+		"  Layers:\n    - Digest: \"sha256:abcd\"\n      CreatedBy: \"/bin/sh -c #(nop) ADD file:1234 in /\"\n",
+		"      Size: 42\n",
+		"Findings:\n  - Scanner: OpenSCAP\n    ID: rule-1\n",
+		"    Result: fail\n    References:\n      - CVE-2015-7547\n",
+		"    References: []\n",
+		"  Efficiency: null\n",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("The yaml output doesn't contain %q:\n%s", expected, out)
+		}
+	}
+}
+
+func TestYAMLString(t *testing.T) {
+	for value, expected := range map[string]string{
+		"plain":        "plain",
+		"two words":    "two words",
+		"":             `""`,
+		"true":         `"true"`,
+		"No":           `"No"`,
+		"1234":         `"1234"`,
+		"key: value":   `"key: value"`,
+		"# comment":    `"# comment"`,
+		"multi\nline":  `"multi\nline"`,
+		"trailing ":    `"trailing "`,
+		"/usr/bin/env": "/usr/bin/env",
+	} {
+		if quoted := yamlString(value); quoted != expected {
+			t.Errorf("%q expected to be written as %s but got %s", value, expected, quoted)
+		}
+	}
+}
+
+func TestRenderTable(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := Render(buf, "table", testReport()); err != nil {
+		t.Fatalf("Unable to render table: %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "sha256:1234") || !strings.Contains(out, "rule-1") ||
+		strings.Contains(out, "rule-2") || !strings.Contains(out, "(1 failed, 1 passed, 0 not applicable)") {
+		t.Errorf("Unexpected table output:\n%s", out)
+	}
+
+	report := testReport()
+	report.Metadata.Container = &iiapi.ContainerMetadata{ID: "5678", Name: "/web", Status: "running",
+		Changes: []iiapi.ContainerChange{{Path: "/tmp/dropped", Kind: iiapi.ChangeAdded}}}
+	buf.Reset()
+	if err := Render(buf, "table", report); err != nil {
+		t.Fatalf("Unable to render table: %v", err)
+	}
+	out = buf.String()
+	if !strings.Contains(out, "CONTAINER     5678     /web  running") || !strings.Contains(out, "added   /tmp/dropped") {
+		t.Errorf("Unexpected table output of a container:\n%s", out)
+	}
+
+	report = testReport()
+	report.Metadata.Platform = &iiapi.Platform{OS: "linux", Architecture: "arm", Variant: "v7"}
+	report.Metadata.Provenance = &iiapi.ImageProvenance{RepoDigest: "docker.io/library/fedora@sha256:5678", Verified: true}
+	report.Metadata.Signatures = &iiapi.SignatureVerification{Scope: "docker.io/library", Error: "no signature"}
+	buf.Reset()
+	if err := Render(buf, "table", report); err != nil {
+		t.Fatalf("Unable to render table: %v", err)
+	}
+	if out = buf.String(); !strings.Contains(out, "PLATFORM      linux/arm/v7") ||
+		!strings.Contains(out, "DIGEST        docker.io/library/fedora@sha256:5678  verified") ||
+		!strings.Contains(out, "SIGNATURES    docker.io/library                     not verified  no signature") {
+		t.Errorf("Unexpected table output of a platform:\n%s", out)
+	}
+}
+
+func TestRenderUnknown(t *testing.T) {
+	if err := Render(&bytes.Buffer{}, "nosuchformat", testReport()); err == nil {
+		t.Errorf("an unknown format should have failed")
+	}
+}
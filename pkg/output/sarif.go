@@ -0,0 +1,221 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+const (
+	SARIFVersion = "2.1.0"
+	SARIFSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	// SARIFImageRoot is the base id of the locations inside the image
+	SARIFImageRoot = "IMAGEROOT"
+)
+
+// sarifToolURIs are the information URIs of the known scanners.
+var sarifToolURIs = map[string]string{
+	"OpenSCAP": "https://www.open-scap.org/",
+}
+
+// sarifSecuritySeverity are the scores used by code scanning dashboards to
+// rank the rules.
+var sarifSecuritySeverity = map[string]string{
+	"critical": "9.0",
+	"high":     "8.0",
+	"medium":   "5.0",
+	"low":      "3.0",
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool              `json:"tool"`
+	Results    []sarifResult          `json:"results"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifRule struct {
+	ID               string                 `json:"id"`
+	ShortDescription sarifMessage           `json:"shortDescription"`
+	Properties       map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId"`
+	RuleIndex  int                    `json:"ruleIndex"`
+	Kind       string                 `json:"kind"`
+	Level      string                 `json:"level"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId"`
+}
+
+func renderSARIF(w io.Writer, report *iiapi.InspectorReport) error {
+	body, err := json.MarshalIndent(newSARIFLog(report), "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(body, '\n'))
+	return err
+}
+
+// newSARIFLog converts the report findings to a SARIF log with a run for
+// each scanner.
+func newSARIFLog(report *iiapi.InspectorReport) *sarifLog {
+	sarif := &sarifLog{
+		Version: SARIFVersion,
+		Schema:  SARIFSchema,
+		Runs:    []sarifRun{},
+	}
+
+	properties := map[string]interface{}{
+		"imageReference": report.Image,
+	}
+	if report.Metadata != nil {
+		properties["imageId"] = report.Metadata.ID
+		properties["repoDigests"] = report.Metadata.RepoDigests
+	}
+
+	runs := map[string]int{}
+	ruleIndexes := map[string]map[string]int{}
+	for _, finding := range report.Findings {
+		runIdx, ok := runs[finding.Scanner]
+		if !ok {
+			runIdx = len(sarif.Runs)
+			runs[finding.Scanner] = runIdx
+			ruleIndexes[finding.Scanner] = map[string]int{}
+			sarif.Runs = append(sarif.Runs, sarifRun{
+				Tool: sarifTool{Driver: sarifDriver{
+					Name:           finding.Scanner,
+					InformationURI: sarifToolURIs[finding.Scanner],
+					Rules:          []sarifRule{},
+				}},
+				Results:    []sarifResult{},
+				Properties: properties,
+			})
+		}
+		run := &sarif.Runs[runIdx]
+
+		ruleIdx, ok := ruleIndexes[finding.Scanner][finding.ID]
+		if !ok {
+			ruleIdx = len(run.Tool.Driver.Rules)
+			ruleIndexes[finding.Scanner][finding.ID] = ruleIdx
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, newSARIFRule(finding))
+		}
+		run.Results = append(run.Results, newSARIFResult(finding, ruleIdx))
+	}
+	return sarif
+}
+
+func newSARIFRule(finding iiapi.Finding) sarifRule {
+	rule := sarifRule{
+		ID:               finding.ID,
+		ShortDescription: sarifMessage{Text: oneLine(finding.Title)},
+		Properties: map[string]interface{}{
+			"severity": finding.Severity,
+			"tags":     finding.References,
+		},
+	}
+	if len(rule.ShortDescription.Text) == 0 {
+		rule.ShortDescription.Text = finding.ID
+	}
+	if score, ok := sarifSecuritySeverity[strings.ToLower(finding.Severity)]; ok {
+		rule.Properties["security-severity"] = score
+	}
+	return rule
+}
+
+func newSARIFResult(finding iiapi.Finding, ruleIdx int) sarifResult {
+	result := sarifResult{
+		RuleID:    finding.ID,
+		RuleIndex: ruleIdx,
+		Kind:      sarifKind(finding.Result),
+		Level:     "none",
+		Message: sarifMessage{
+			Text: fmt.Sprintf("%s: %s", finding.Result, oneLine(finding.Title)),
+		},
+		Properties: map[string]interface{}{
+			"result": finding.Result,
+		},
+	}
+	if result.Kind == "fail" {
+		result.Level = sarifLevel(finding.Severity)
+	}
+	if len(finding.Path) > 0 {
+		result.Locations = []sarifLocation{{
+			PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{
+					URI:       strings.TrimPrefix(finding.Path, "/"),
+					URIBaseID: SARIFImageRoot,
+				},
+			},
+		}}
+	}
+	if finding.Layer != nil {
+		result.Properties["layerDigest"] = finding.Layer.Digest
+		result.Properties["layerCreatedBy"] = finding.Layer.CreatedBy
+	}
+	return result
+}
+
+// sarifKind maps the result of a check to a SARIF result kind.
+func sarifKind(result iiapi.FindingResult) string {
+	switch result {
+	case iiapi.ResultPass, iiapi.ResultFixed:
+		return "pass"
+	case iiapi.ResultFail:
+		return "fail"
+	case iiapi.ResultNotApplicable, iiapi.ResultNotSelected:
+		return "notApplicable"
+	case iiapi.ResultInformational:
+		return "informational"
+	}
+	// error, unknown and notchecked need somebody to look at them
+	return "review"
+}
+
+// sarifLevel maps the severity of a failed check to a SARIF level.
+func sarifLevel(severity string) string {
+	switch strings.ToLower(severity) {
+	case "critical", "high":
+		return "error"
+	case "medium", "moderate":
+		return "warning"
+	}
+	return "note"
+}
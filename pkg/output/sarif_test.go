@@ -0,0 +1,77 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+func TestRenderSARIF(t *testing.T) {
+	report := testReport()
+	report.Image = "fedora:22"
+	report.Findings = append(report.Findings,
+		iiapi.Finding{Scanner: "OpenSCAP", ID: "rule-1", Title: "RHSA-2016:0176: glibc (Critical)",
+			Severity: "high", Result: iiapi.ResultFail},
+		iiapi.Finding{Scanner: "secrets", ID: "private-key", Title: "Private key",
+			Severity: "medium", Result: iiapi.ResultFail, Path: "/etc/pki/server.key",
+			Layer: &report.Metadata.Layers[0]})
+
+	buf := &bytes.Buffer{}
+	if err := Render(buf, "sarif", report); err != nil {
+		t.Fatalf("Unable to render sarif: %v", err)
+	}
+	var sarif sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &sarif); err != nil {
+		t.Fatalf("The sarif output can't be parsed: %v", err)
+	}
+
+	if sarif.Version != SARIFVersion || len(sarif.Runs) != 2 {
+		t.Fatalf("Expected a SARIF %s log with 2 runs but got %s", SARIFVersion, buf.String())
+	}
+	oscap := sarif.Runs[0]
+	if oscap.Tool.Driver.Name != "OpenSCAP" || len(oscap.Tool.Driver.Rules) != 2 || len(oscap.Results) != 3 {
+		t.Errorf("Unexpected OpenSCAP run %+v", oscap)
+	}
+	if oscap.Properties["imageReference"] != "fedora:22" || oscap.Properties["imageId"] != "sha256:1234" {
+		t.Errorf("Unexpected run properties %v", oscap.Properties)
+	}
+	for idx, expected := range []struct {
+		ruleIndex   int
+		kind, level string
+	}{
+		{0, "fail", "error"},
+		{1, "pass", "none"},
+		{0, "fail", "error"},
+	} {
+		result := oscap.Results[idx]
+		if result.RuleIndex != expected.ruleIndex || result.Kind != expected.kind || result.Level != expected.level {
+			t.Errorf("Unexpected result %d: %+v", idx, result)
+		}
+	}
+	if oscap.Tool.Driver.Rules[0].Properties["security-severity"] != "8.0" {
+		t.Errorf("Unexpected rule properties %v", oscap.Tool.Driver.Rules[0].Properties)
+	}
+
+	secret := sarif.Runs[1].Results[0]
+	if secret.Level != "warning" || len(secret.Locations) != 1 ||
+		secret.Locations[0].PhysicalLocation.ArtifactLocation.URI != "etc/pki/server.key" ||
+		secret.Properties["layerDigest"] != "sha256:abcd" {
+		t.Errorf("Unexpected located result %+v", secret)
+	}
+}
+
+func TestSARIFKind(t *testing.T) {
+	for result, kind := range map[iiapi.FindingResult]string{
+		iiapi.ResultPass:          "pass",
+		iiapi.ResultFail:          "fail",
+		iiapi.ResultNotApplicable: "notApplicable",
+		iiapi.ResultError:         "review",
+		iiapi.ResultInformational: "informational",
+	} {
+		if sarifKind(result) != kind {
+			t.Errorf("%s expected to be of kind %s but got %s", result, kind, sarifKind(result))
+		}
+	}
+}
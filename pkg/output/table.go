@@ -0,0 +1,97 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+// renderTable writes a human readable summary of the report. Passed and not
+// applicable checks are only counted, not listed.
+func renderTable(w io.Writer, report *iiapi.InspectorReport) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	meta := report.Metadata
+
+	fmt.Fprintf(tw, "IMAGE\t%s\n", meta.ID)
+	fmt.Fprintf(tw, "TAGS\t%s\n", strings.Join(meta.RepoTags, ", "))
+	fmt.Fprintf(tw, "CREATED\t%s\n", meta.Created.Format(time.RFC3339))
+	fmt.Fprintf(tw, "ARCHITECTURE\t%s\n", meta.Architecture)
+	if meta.Platform != nil {
+		fmt.Fprintf(tw, "PLATFORM\t%s\n", meta.Platform)
+	}
+	if meta.Provenance != nil {
+		verified := "not verified"
+		if meta.Provenance.Verified {
+			verified = "verified"
+		}
+		if meta.Provenance.Unresolved {
+			verified += ", tag not resolved before the pull"
+		}
+		fmt.Fprintf(tw, "DIGEST\t%s\t%s\n", meta.Provenance.RepoDigest, verified)
+	}
+	if meta.Signatures != nil {
+		verified, scope := "not verified", meta.Signatures.Scope
+		if meta.Signatures.Verified {
+			verified = "verified"
+		}
+		if len(scope) == 0 {
+			scope = "default"
+		}
+		fmt.Fprintf(tw, "SIGNATURES\t%s\t%s\t%s\n", scope, verified, meta.Signatures.Error)
+	}
+	fmt.Fprintf(tw, "SIZE\t%d\n", meta.VirtualSize)
+	if meta.Container != nil {
+		fmt.Fprintf(tw, "CONTAINER\t%s\t%s\t%s\n", meta.Container.ID, meta.Container.Name, meta.Container.Status)
+	}
+	if meta.OpenSCAP != nil {
+		fmt.Fprintf(tw, "OPENSCAP\t%s\t%s\n", meta.OpenSCAP.Status, meta.OpenSCAP.ErrorMessage)
+	}
+	if meta.Efficiency != nil {
+		fmt.Fprintf(tw, "EFFICIENCY\t%.4f\t(%d of %d bytes wasted)\n", meta.Efficiency.Score,
+			meta.Efficiency.WastedBytes, meta.Efficiency.TotalBytes)
+	}
+
+	if len(meta.Layers) > 0 {
+		fmt.Fprintf(tw, "\nLAYER\tSIZE\tFILES\tCREATED BY\n")
+		for idx, layer := range meta.Layers {
+			fmt.Fprintf(tw, "%d\t%d\t%d\t%s\n", idx, layer.Size, layer.Files, oneLine(layer.CreatedBy))
+		}
+	}
+
+	if meta.Container != nil && len(meta.Container.Changes) > 0 {
+		fmt.Fprintf(tw, "\nCHANGE\tPATH\n")
+		for _, change := range meta.Container.Changes {
+			fmt.Fprintf(tw, "%s\t%s\n", change.Kind, change.Path)
+		}
+	}
+
+	counts := map[iiapi.FindingResult]int{}
+	listed := []iiapi.Finding{}
+	for _, finding := range report.Findings {
+		counts[finding.Result]++
+		if finding.Result != iiapi.ResultPass && finding.Result != iiapi.ResultNotApplicable {
+			listed = append(listed, finding)
+		}
+	}
+	if len(report.Findings) > 0 {
+		fmt.Fprintf(tw, "\nFINDINGS\t%d\t(%d failed, %d passed, %d not applicable)\n", len(report.Findings),
+			counts[iiapi.ResultFail], counts[iiapi.ResultPass], counts[iiapi.ResultNotApplicable])
+	}
+	if len(listed) > 0 {
+		fmt.Fprintf(tw, "\nSCANNER\tRESULT\tSEVERITY\tID\tTITLE\n")
+		for _, finding := range listed {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", finding.Scanner, finding.Result,
+				finding.Severity, finding.ID, oneLine(finding.Title))
+		}
+	}
+	return tw.Flush()
+}
+
+// oneLine collapses s on a single line so that it doesn't break the table.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
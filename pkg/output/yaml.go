@@ -0,0 +1,162 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+// yamlNode is a JSON value keeping the order of the object keys.
+type yamlNode struct {
+	// scalar is the YAML representation of a scalar value
+	scalar string
+	// keys and values of an object
+	keys   []string
+	values []*yamlNode
+	// items of an array
+	items []*yamlNode
+	// isObject and isArray tell what kind of composite value this is
+	isObject bool
+	isArray  bool
+}
+
+// yamlPlain matches the strings that can be safely written without quotes.
+var yamlPlain = regexp.MustCompile(`^[A-Za-z_/.][A-Za-z0-9_./+@()-]*( [A-Za-z0-9_./+@()-]+)*$`)
+
+// yamlReserved are the plain strings YAML would read as something else.
+var yamlReserved = map[string]bool{
+	"true": true, "false": true, "null": true, "yes": true, "no": true,
+	"on": true, "off": true, "y": true, "n": true, "~": true,
+	".inf": true, ".nan": true,
+}
+
+// renderYAML writes the report as YAML. The report is serialized to JSON
+// first so that the YAML output follows the same field names.
+func renderYAML(w io.Writer, report *iiapi.InspectorReport) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	node, err := decodeYAMLNode(dec)
+	if err != nil {
+		return err
+	}
+	buf := &bytes.Buffer{}
+	writeYAMLNode(buf, node, 0)
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// decodeYAMLNode reads the next JSON value from dec.
+func decodeYAMLNode(dec *json.Decoder) (*yamlNode, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch value := token.(type) {
+	case json.Delim:
+		node := &yamlNode{isObject: value == '{', isArray: value == '['}
+		for dec.More() {
+			if node.isObject {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				node.keys = append(node.keys, key.(string))
+			}
+			child, err := decodeYAMLNode(dec)
+			if err != nil {
+				return nil, err
+			}
+			if node.isObject {
+				node.values = append(node.values, child)
+			} else {
+				node.items = append(node.items, child)
+			}
+		}
+		// consume the closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return node, nil
+	case string:
+		return &yamlNode{scalar: yamlString(value)}, nil
+	case json.Number:
+		return &yamlNode{scalar: value.String()}, nil
+	case bool:
+		return &yamlNode{scalar: fmt.Sprintf("%t", value)}, nil
+	case nil:
+		return &yamlNode{scalar: "null"}, nil
+	}
+	return nil, fmt.Errorf("Unexpected JSON token %v", token)
+}
+
+// yamlString returns s quoted if needed. JSON strings are valid YAML double
+// quoted scalars.
+func yamlString(s string) string {
+	if yamlPlain.MatchString(s) && !yamlReserved[strings.ToLower(s)] {
+		return s
+	}
+	quoted, _ := json.Marshal(s)
+	return string(quoted)
+}
+
+// isEmpty returns true for empty objects and arrays, written inline.
+func (n *yamlNode) isEmpty() bool {
+	return (n.isObject && len(n.keys) == 0) || (n.isArray && len(n.items) == 0)
+}
+
+// inline returns the representation of scalars and empty composites.
+func (n *yamlNode) inline() string {
+	switch {
+	case n.isObject:
+		return "{}"
+	case n.isArray:
+		return "[]"
+	}
+	return n.scalar
+}
+
+// writeYAMLNode writes a composite node with the given indentation.
+func writeYAMLNode(buf *bytes.Buffer, node *yamlNode, indent int) {
+	pad := strings.Repeat(" ", indent)
+	if !node.isObject && !node.isArray || node.isEmpty() {
+		fmt.Fprintf(buf, "%s%s\n", pad, node.inline())
+		return
+	}
+	if node.isObject {
+		for idx, key := range node.keys {
+			writeYAMLEntry(buf, pad+yamlString(key)+":", node.values[idx], indent)
+		}
+		return
+	}
+	for _, item := range node.items {
+		if item.isObject && !item.isEmpty() {
+			// the first key goes on the same line of the dash
+			writeYAMLEntry(buf, pad+"- "+yamlString(item.keys[0])+":", item.values[0], indent+2)
+			for idx := 1; idx < len(item.keys); idx++ {
+				writeYAMLEntry(buf, pad+"  "+yamlString(item.keys[idx])+":", item.values[idx], indent+2)
+			}
+			continue
+		}
+		writeYAMLEntry(buf, pad+"-", item, indent)
+	}
+}
+
+// writeYAMLEntry writes prefix followed by value, either on the same line or
+// nested below it.
+func writeYAMLEntry(buf *bytes.Buffer, prefix string, value *yamlNode, indent int) {
+	if !value.isObject && !value.isArray || value.isEmpty() {
+		fmt.Fprintf(buf, "%s %s\n", prefix, value.inline())
+		return
+	}
+	fmt.Fprintf(buf, "%s\n", prefix)
+	writeYAMLNode(buf, value, indent+2)
+}
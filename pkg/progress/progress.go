@@ -0,0 +1,115 @@
+package progress
+
+import (
+	"sync"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+// Tracker records the progress of an inspection and notifies its
+// subscribers of the changes. All the methods can be called on a nil
+// Tracker, doing nothing.
+type Tracker struct {
+	lock        sync.Mutex
+	progress    iiapi.InspectionProgress
+	subscribers map[chan struct{}]bool
+}
+
+// NewTracker returns the tracker of the inspection of image, which is
+// about to be pulled.
+func NewTracker(image string) *Tracker {
+	return &Tracker{
+		progress: iiapi.InspectionProgress{
+			Image:   image,
+			Stage:   iiapi.ProgressPulling,
+			Layers:  map[string]iiapi.LayerProgress{},
+			Updated: time.Now(),
+		},
+		subscribers: map[chan struct{}]bool{},
+	}
+}
+
+// Get returns the current progress.
+func (t *Tracker) Get() iiapi.InspectionProgress {
+	if t == nil {
+		return iiapi.InspectionProgress{}
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	progress := t.progress
+	progress.Layers = make(map[string]iiapi.LayerProgress, len(t.progress.Layers))
+	for id, layer := range t.progress.Layers {
+		progress.Layers[id] = layer
+	}
+	return progress
+}
+
+// SetStage records that the inspection entered stage.
+func (t *Tracker) SetStage(stage iiapi.ProgressStage) {
+	t.update(func(p *iiapi.InspectionProgress) {
+		p.Stage = stage
+	})
+}
+
+// SetLayerBytes records the progress of the download of the layer id.
+func (t *Tracker) SetLayerBytes(id string, downloaded, total int64) {
+	t.update(func(p *iiapi.InspectionProgress) {
+		p.Layers[id] = iiapi.LayerProgress{Downloaded: downloaded, Total: total}
+	})
+}
+
+// FileExtracted counts a file extracted from the image.
+func (t *Tracker) FileExtracted() {
+	t.update(func(p *iiapi.InspectionProgress) {
+		p.FilesExtracted++
+	})
+}
+
+// Fail records that the inspection failed with err.
+func (t *Tracker) Fail(err error) {
+	t.update(func(p *iiapi.InspectionProgress) {
+		p.Stage = iiapi.ProgressFailed
+		p.ErrorMessage = err.Error()
+	})
+}
+
+// Done returns true once the inspection is ready or failed.
+func (t *Tracker) Done() bool {
+	return t.Get().Done()
+}
+
+// Subscribe returns a channel receiving a value when the progress changes,
+// the changes happening before the value is received are coalesced. The
+// returned function cancels the subscription.
+func (t *Tracker) Subscribe() (<-chan struct{}, func()) {
+	changes := make(chan struct{}, 1)
+	if t == nil {
+		return changes, func() {}
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.subscribers[changes] = true
+	return changes, func() {
+		t.lock.Lock()
+		defer t.lock.Unlock()
+		delete(t.subscribers, changes)
+	}
+}
+
+// update changes the progress with fn and notifies the subscribers.
+func (t *Tracker) update(fn func(*iiapi.InspectionProgress)) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	fn(&t.progress)
+	t.progress.Updated = time.Now()
+	for changes := range t.subscribers {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package progress
+
+import (
+	"fmt"
+	"testing"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+func TestTracker(t *testing.T) {
+	tracker := NewTracker("fedora:22")
+	changes, cancel := tracker.Subscribe()
+	defer cancel()
+
+	tracker.SetLayerBytes("abc", 10, 100)
+	tracker.SetLayerBytes("abc", 50, 100)
+	tracker.SetStage(iiapi.ProgressExtracting)
+	tracker.FileExtracted()
+	tracker.FileExtracted()
+
+	select {
+	case <-changes:
+	default:
+		t.Errorf("the subscriber should have been notified")
+	}
+	select {
+	case <-changes:
+		t.Errorf("the changes should have been coalesced")
+	default:
+	}
+
+	current := tracker.Get()
+	if current.Image != "fedora:22" || current.Stage != iiapi.ProgressExtracting || current.FilesExtracted != 2 {
+		t.Errorf("unexpected progress %#v", current)
+	}
+	if current.Layers["abc"] != (iiapi.LayerProgress{Downloaded: 50, Total: 100}) {
+		t.Errorf("unexpected layer progress %#v", current.Layers)
+	}
+	// the returned progress is a copy
+	current.Layers["abc"] = iiapi.LayerProgress{}
+	if tracker.Get().Layers["abc"].Downloaded != 50 {
+		t.Errorf("changing the returned progress should not change the tracker")
+	}
+	if tracker.Done() {
+		t.Errorf("an extracting inspection is not done")
+	}
+
+	tracker.Fail(fmt.Errorf("no space left"))
+	if current = tracker.Get(); current.Stage != iiapi.ProgressFailed || current.ErrorMessage != "no space left" || !tracker.Done() {
+		t.Errorf("unexpected failed progress %#v", current)
+	}
+
+	cancel()
+	tracker.SetStage(iiapi.ProgressReady)
+	select {
+	case <-changes:
+		// the notification of the failure
+	default:
+	}
+	select {
+	case <-changes:
+		t.Errorf("a cancelled subscription should not be notified")
+	default:
+	}
+
+	// a nil tracker does nothing
+	var nilTracker *Tracker
+	nilTracker.SetStage(iiapi.ProgressScanning)
+	nilTracker.FileExtracted()
+	if _, cancel := nilTracker.Subscribe(); cancel == nil {
+		t.Errorf("the subscription of a nil tracker should be cancellable")
+	}
+}
@@ -0,0 +1,209 @@
+package publish
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/kube"
+)
+
+const (
+	PUBLISH_POD      = "pod"
+	PUBLISH_IMAGE    = "image"
+	PUBLISH_RESOURCE = "resource"
+
+	// ANNOTATION_PREFIX is the prefix of the annotations of the summary
+	ANNOTATION_PREFIX = "image-inspector.openshift.io/"
+
+	IMAGES_API_PATH       = "/apis/image.openshift.io/v1/images"
+	SCAN_RESULT_GROUP     = "image-inspector.openshift.io"
+	SCAN_RESULT_VERSION   = "v1alpha1"
+	SCAN_RESULT_KIND      = "ImageScanResult"
+	SCAN_RESULT_API_PATH  = "/apis/" + SCAN_RESULT_GROUP + "/" + SCAN_RESULT_VERSION
+	SCAN_RESULT_RESOURCES = "imagescanresults"
+	UNKNOWN_SEVERITY      = "unknown"
+)
+
+// Client sends requests to the Kubernetes API, it's implemented by
+// kube.Client.
+type Client interface {
+	Do(method, path, contentType string, body, out interface{}) error
+}
+
+// ensures this always implements the interface or fail compilation.
+var _ Client = &kube.Client{}
+
+// Publisher publishes the summaries of the inspections to Kubernetes.
+type Publisher interface {
+	// Publish publishes summary, on pods, as namespace/name, when
+	// annotating the pods running the image.
+	Publish(summary *iiapi.ScanSummary, pods []string) error
+}
+
+// NewPublisher returns the publisher of kind, one of iiapi.PublishOptions,
+// using client. The custom resources are created in namespace.
+func NewPublisher(kind string, client Client, namespace string) (Publisher, error) {
+	switch kind {
+	case PUBLISH_POD:
+		return &podAnnotator{client: client}, nil
+	case PUBLISH_IMAGE:
+		return &imageAnnotator{client: client}, nil
+	case PUBLISH_RESOURCE:
+		return &resourcePublisher{client: client, namespace: namespace}, nil
+	}
+	return nil, fmt.Errorf("%s is not one of the available publish options which are %v", kind, iiapi.PublishOptions)
+}
+
+// NewScanSummary returns the summary of the inspection of report, ended at
+// scanTime, whose results are served on resultsURL.
+func NewScanSummary(report *iiapi.InspectorReport, scanTime time.Time, resultsURL string) *iiapi.ScanSummary {
+	summary := &iiapi.ScanSummary{
+		Image:           report.Image,
+		Vulnerabilities: map[string]int{},
+		OpenSCAPStatus:  iiapi.StatusNotRequested,
+		ScanTime:        scanTime,
+		ResultsURL:      resultsURL,
+	}
+	for _, severity := range iiapi.SeverityOptions {
+		summary.Vulnerabilities[severity] = 0
+	}
+	for _, finding := range report.Findings {
+		if finding.Result != iiapi.ResultFail {
+			continue
+		}
+		severity := strings.ToLower(finding.Severity)
+		if len(severity) == 0 {
+			severity = UNKNOWN_SEVERITY
+		}
+		summary.Vulnerabilities[severity]++
+	}
+	if report.Metadata == nil {
+		return summary
+	}
+	summary.ImageID = report.Metadata.ID
+	if report.Metadata.OpenSCAP != nil {
+		summary.OpenSCAPStatus = report.Metadata.OpenSCAP.Status
+	}
+	_, summary.ImageDigest = report.Metadata.RepoDigest()
+	return summary
+}
+
+// Annotations returns the annotations describing summary.
+func Annotations(summary *iiapi.ScanSummary) map[string]string {
+	vulnerabilities, _ := json.Marshal(summary.Vulnerabilities)
+	annotations := map[string]string{
+		ANNOTATION_PREFIX + "vulnerabilities": string(vulnerabilities),
+		ANNOTATION_PREFIX + "openscap-status": string(summary.OpenSCAPStatus),
+		ANNOTATION_PREFIX + "scan-time":       summary.ScanTime.UTC().Format(time.RFC3339),
+		ANNOTATION_PREFIX + "image-id":        summary.ImageID,
+	}
+	if len(summary.ImageDigest) > 0 {
+		annotations[ANNOTATION_PREFIX+"image-digest"] = summary.ImageDigest
+	}
+	if len(summary.ResultsURL) > 0 {
+		annotations[ANNOTATION_PREFIX+"results-url"] = summary.ResultsURL
+	}
+	return annotations
+}
+
+// annotationsPatch returns the merge patch setting the annotations of summary.
+func annotationsPatch(summary *iiapi.ScanSummary) interface{} {
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": Annotations(summary)},
+	}
+}
+
+// podAnnotator annotates the pods running the image.
+type podAnnotator struct {
+	client Client
+}
+
+func (p *podAnnotator) Publish(summary *iiapi.ScanSummary, pods []string) error {
+	var failed []string
+	for _, pod := range pods {
+		parts := strings.SplitN(pod, "/", 2)
+		if len(parts) != 2 {
+			failed = append(failed, fmt.Sprintf("%s is not a namespace/name", pod))
+			continue
+		}
+		path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s", parts[0], parts[1])
+		if err := p.client.Do("PATCH", path, kube.MERGE_PATCH, annotationsPatch(summary), nil); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", pod, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("Unable to annotate the pods: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// imageAnnotator annotates the OpenShift Image of the image, named after
+// the digest of its manifest.
+type imageAnnotator struct {
+	client Client
+}
+
+func (p *imageAnnotator) Publish(summary *iiapi.ScanSummary, pods []string) error {
+	if len(summary.ImageDigest) == 0 {
+		return fmt.Errorf("Unable to annotate the image %s without manifest digest", summary.Image)
+	}
+	if err := p.client.Do("PATCH", IMAGES_API_PATH+"/"+summary.ImageDigest, kube.MERGE_PATCH, annotationsPatch(summary), nil); err != nil {
+		return fmt.Errorf("Unable to annotate the image %s: %v", summary.ImageDigest, err)
+	}
+	return nil
+}
+
+// scanResult is an ImageScanResult custom resource.
+type scanResult struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   kube.ObjectMeta   `json:"metadata"`
+	Spec       iiapi.ScanSummary `json:"spec"`
+}
+
+// resourcePublisher creates or updates an ImageScanResult for each image,
+// named after its digest.
+type resourcePublisher struct {
+	client    Client
+	namespace string
+}
+
+func (p *resourcePublisher) Publish(summary *iiapi.ScanSummary, pods []string) error {
+	name, err := ScanResultName(summary)
+	if err != nil {
+		return err
+	}
+	resources := fmt.Sprintf("%s/namespaces/%s/%s", SCAN_RESULT_API_PATH, p.namespace, SCAN_RESULT_RESOURCES)
+	resource := scanResult{
+		APIVersion: SCAN_RESULT_GROUP + "/" + SCAN_RESULT_VERSION,
+		Kind:       SCAN_RESULT_KIND,
+		Metadata:   kube.ObjectMeta{Name: name, Namespace: p.namespace},
+		Spec:       *summary,
+	}
+	err = p.client.Do("POST", resources, "", resource, nil)
+	if kube.IsStatus(err, http.StatusConflict) {
+		// replaces the summary of a previous inspection
+		err = p.client.Do("PATCH", resources+"/"+name, kube.MERGE_PATCH, map[string]interface{}{"spec": summary}, nil)
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to publish the %s %s/%s: %v", SCAN_RESULT_KIND, p.namespace, name, err)
+	}
+	return nil
+}
+
+// ScanResultName returns the name of the ImageScanResult of the image of
+// summary, sha256-<hex> after the digest of its manifest or its id.
+func ScanResultName(summary *iiapi.ScanSummary) (string, error) {
+	digest := summary.ImageDigest
+	if len(digest) == 0 {
+		digest = summary.ImageID
+	}
+	if len(digest) == 0 {
+		return "", fmt.Errorf("Unable to name the %s of %s without digest", SCAN_RESULT_KIND, summary.Image)
+	}
+	return strings.Replace(digest, ":", "-", 1), nil
+}
@@ -0,0 +1,190 @@
+package publish
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/kube"
+)
+
+// fakeClient records the requests and answers with the errors of its map,
+// keyed by method and path.
+type fakeClient struct {
+	errors   map[string]error
+	requests []string
+	bodies   []string
+}
+
+func (c *fakeClient) Do(method, path, contentType string, body, out interface{}) error {
+	encoded, _ := json.Marshal(body)
+	c.requests = append(c.requests, fmt.Sprintf("%s %s %s", method, path, contentType))
+	c.bodies = append(c.bodies, string(encoded))
+	return c.errors[method+" "+path]
+}
+
+func newTestSummary() *iiapi.ScanSummary {
+	meta := &iiapi.InspectorMetadata{OpenSCAP: &iiapi.OpenSCAPMetadata{Status: iiapi.StatusSuccess}}
+	meta.ID = "sha256:aaaa"
+	meta.RepoDigests = []string{"docker.io/library/fedora@sha256:bbbb"}
+	report := &iiapi.InspectorReport{
+		Image:    "fedora:26",
+		Metadata: meta,
+		Findings: []iiapi.Finding{
+			{ID: "CVE-2017-0001", Severity: "High", Result: iiapi.ResultFail},
+			{ID: "CVE-2017-0002", Severity: "high", Result: iiapi.ResultFail},
+			{ID: "CVE-2017-0003", Severity: "low", Result: iiapi.ResultFail},
+			{ID: "CVE-2017-0004", Severity: "", Result: iiapi.ResultFail},
+			{ID: "CVE-2017-0005", Severity: "critical", Result: iiapi.ResultPass},
+		},
+	}
+	return NewScanSummary(report, time.Date(2017, 6, 1, 12, 0, 0, 0, time.UTC), "https://inspector.example.com/api/v1/openscap")
+}
+
+func TestNewScanSummary(t *testing.T) {
+	summary := newTestSummary()
+	expected := map[string]int{"critical": 0, "high": 2, "medium": 0, "low": 1, "unknown": 1}
+	if !reflect.DeepEqual(summary.Vulnerabilities, expected) {
+		t.Errorf("Expected the vulnerabilities %v but got %v", expected, summary.Vulnerabilities)
+	}
+	if summary.ImageDigest != "sha256:bbbb" || summary.ImageID != "sha256:aaaa" || summary.OpenSCAPStatus != iiapi.StatusSuccess {
+		t.Errorf("Unexpected summary %+v", summary)
+	}
+
+	// the digest the image was pinned to wins over the repo digests of the runtime
+	pinned := &iiapi.InspectorReport{Image: "fedora:26", Metadata: &iiapi.InspectorMetadata{
+		Provenance: &iiapi.ImageProvenance{RepoDigest: "quay.io/fedora/fedora@sha256:cccc"},
+	}}
+	pinned.Metadata.RepoDigests = []string{"docker.io/library/fedora@sha256:bbbb", "quay.io/fedora/fedora@sha256:cccc"}
+	if digest := NewScanSummary(pinned, time.Now(), "").ImageDigest; digest != "sha256:cccc" {
+		t.Errorf("Expected the pinned digest but got %s", digest)
+	}
+
+	empty := NewScanSummary(&iiapi.InspectorReport{Image: "fedora:26"}, time.Now(), "")
+	if empty.OpenSCAPStatus != iiapi.StatusNotRequested || len(empty.ImageDigest) > 0 || empty.Vulnerabilities["high"] != 0 {
+		t.Errorf("Unexpected summary without metadata %+v", empty)
+	}
+}
+
+func TestAnnotations(t *testing.T) {
+	annotations := Annotations(newTestSummary())
+	expected := map[string]string{
+		"image-inspector.openshift.io/vulnerabilities": `{"critical":0,"high":2,"low":1,"medium":0,"unknown":1}`,
+		"image-inspector.openshift.io/openscap-status": "Success",
+		"image-inspector.openshift.io/scan-time":       "2017-06-01T12:00:00Z",
+		"image-inspector.openshift.io/image-id":        "sha256:aaaa",
+		"image-inspector.openshift.io/image-digest":    "sha256:bbbb",
+		"image-inspector.openshift.io/results-url":     "https://inspector.example.com/api/v1/openscap",
+	}
+	if !reflect.DeepEqual(annotations, expected) {
+		t.Errorf("Expected the annotations %v but got %v", expected, annotations)
+	}
+}
+
+func TestPublishers(t *testing.T) {
+	noDigest := newTestSummary()
+	noDigest.ImageDigest = ""
+	scanResults := "/apis/image-inspector.openshift.io/v1alpha1/namespaces/security/imagescanresults"
+
+	for k, v := range map[string]struct {
+		kind       string
+		summary    *iiapi.ScanSummary
+		pods       []string
+		errors     map[string]error
+		requests   []string
+		shouldFail bool
+	}{
+		"pods": {
+			kind: PUBLISH_POD,
+			pods: []string{"shop/web-1", "tools/build"},
+			requests: []string{
+				"PATCH /api/v1/namespaces/shop/pods/web-1 " + kube.MERGE_PATCH,
+				"PATCH /api/v1/namespaces/tools/pods/build " + kube.MERGE_PATCH,
+			},
+		},
+		"pods with a failure": {
+			kind:   PUBLISH_POD,
+			pods:   []string{"shop/web-1", "web-2", "shop/web-3"},
+			errors: map[string]error{"PATCH /api/v1/namespaces/shop/pods/web-1": &kube.StatusError{Code: http.StatusNotFound, Message: "not found"}},
+			requests: []string{
+				"PATCH /api/v1/namespaces/shop/pods/web-1 " + kube.MERGE_PATCH,
+				"PATCH /api/v1/namespaces/shop/pods/web-3 " + kube.MERGE_PATCH,
+			},
+			shouldFail: true,
+		},
+		"image": {
+			kind:     PUBLISH_IMAGE,
+			requests: []string{"PATCH /apis/image.openshift.io/v1/images/sha256:bbbb " + kube.MERGE_PATCH},
+		},
+		"image without digest": {
+			kind:       PUBLISH_IMAGE,
+			summary:    noDigest,
+			requests:   []string{},
+			shouldFail: true,
+		},
+		"new resource": {
+			kind:     PUBLISH_RESOURCE,
+			requests: []string{"POST " + scanResults + " "},
+		},
+		"existing resource": {
+			kind:   PUBLISH_RESOURCE,
+			errors: map[string]error{"POST " + scanResults: &kube.StatusError{Code: http.StatusConflict, Message: "already exists"}},
+			requests: []string{
+				"POST " + scanResults + " ",
+				"PATCH " + scanResults + "/sha256-bbbb " + kube.MERGE_PATCH,
+			},
+		},
+		"resource named after the image id": {
+			kind:     PUBLISH_RESOURCE,
+			summary:  noDigest,
+			requests: []string{"POST " + scanResults + " "},
+		},
+		"forbidden resource": {
+			kind:       PUBLISH_RESOURCE,
+			errors:     map[string]error{"POST " + scanResults: &kube.StatusError{Code: http.StatusForbidden, Message: "forbidden"}},
+			requests:   []string{"POST " + scanResults + " "},
+			shouldFail: true,
+		},
+	} {
+		client := &fakeClient{errors: v.errors, requests: []string{}}
+		publisher, err := NewPublisher(v.kind, client, "security")
+		if err != nil {
+			t.Fatalf("%s: unable to create the publisher: %v", k, err)
+		}
+		summary := v.summary
+		if summary == nil {
+			summary = newTestSummary()
+		}
+		err = publisher.Publish(summary, v.pods)
+		if v.shouldFail && err == nil {
+			t.Errorf("%s should have failed", k)
+		} else if !v.shouldFail && err != nil {
+			t.Errorf("%s failed: %v", k, err)
+		}
+		if !reflect.DeepEqual(client.requests, v.requests) {
+			t.Errorf("%s: expected the requests %v but got %v", k, v.requests, client.requests)
+		}
+		if v.kind != PUBLISH_RESOURCE && len(client.bodies) > 0 && !strings.Contains(client.bodies[0], `"image-inspector.openshift.io/openscap-status":"Success"`) {
+			t.Errorf("%s: unexpected patch %s", k, client.bodies[0])
+		}
+		if v.kind == PUBLISH_RESOURCE && len(client.bodies) > 0 {
+			var resource scanResult
+			if err := json.Unmarshal([]byte(client.bodies[0]), &resource); err != nil {
+				t.Errorf("%s: unable to parse the resource %s: %v", k, client.bodies[0], err)
+			}
+			name, _ := ScanResultName(summary)
+			if resource.Kind != SCAN_RESULT_KIND || resource.Metadata.Name != name || resource.Metadata.Namespace != "security" || resource.Spec.Vulnerabilities["high"] != 2 {
+				t.Errorf("%s: unexpected resource %+v", k, resource)
+			}
+		}
+	}
+
+	if _, err := NewPublisher("configmap", &fakeClient{}, ""); err == nil {
+		t.Errorf("Publishing configmaps should have failed")
+	}
+}
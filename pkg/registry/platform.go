@@ -0,0 +1,50 @@
+package registry
+
+import (
+	"fmt"
+	"strings"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+// ParsePlatform parses platform, which is os/arch[/variant].
+func ParsePlatform(platform string) (iiapi.Platform, error) {
+	parts := strings.Split(strings.ToLower(platform), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return iiapi.Platform{}, fmt.Errorf("%s is not a platform, please use os/arch[/variant]", platform)
+	}
+	for _, part := range parts {
+		if len(part) == 0 {
+			return iiapi.Platform{}, fmt.Errorf("%s is not a platform, please use os/arch[/variant]", platform)
+		}
+	}
+	p := iiapi.Platform{OS: parts[0], Architecture: parts[1]}
+	if len(parts) == 3 {
+		p.Variant = parts[2]
+	}
+	return p, nil
+}
+
+// PlatformOf returns the platform of the manifest list entry desc.
+func PlatformOf(desc ManifestDescriptor) iiapi.Platform {
+	return iiapi.Platform{
+		OS:           desc.Platform.OS,
+		Architecture: desc.Platform.Architecture,
+		Variant:      desc.Platform.Variant,
+	}
+}
+
+// MatchPlatform returns the entry of descriptors for platform, any variant
+// of the architecture matching when platform has none.
+func MatchPlatform(descriptors []ManifestDescriptor, platform iiapi.Platform) (*ManifestDescriptor, error) {
+	available := []string{}
+	for idx := range descriptors {
+		p := PlatformOf(descriptors[idx])
+		if p.OS == platform.OS && p.Architecture == platform.Architecture &&
+			(len(platform.Variant) == 0 || p.Variant == platform.Variant) {
+			return &descriptors[idx], nil
+		}
+		available = append(available, p.String())
+	}
+	return nil, fmt.Errorf("The image has no %s platform, the available platforms are %v", platform, available)
+}
@@ -0,0 +1,116 @@
+package registry
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	DOCKER_HUB        = "docker.io"
+	DOCKER_HUB_LEGACY = "index.docker.io"
+	// DOCKER_HUB_API is the host serving the registry API of the docker hub
+	DOCKER_HUB_API     = "registry-1.docker.io"
+	DOCKER_HUB_LIBRARY = "library/"
+	DEFAULT_TAG        = "latest"
+)
+
+// Reference is a parsed image reference, fully qualified.
+type Reference struct {
+	// Registry is the host, and port, of the registry
+	Registry string
+	// Repository is the path of the image in the registry
+	Repository string
+	// Tag is empty for the references by digest without a tag
+	Tag string
+	// Digest is empty for the references by tag
+	Digest string
+}
+
+// ParseReference parses image, which is
+// [registry/]repository[:tag][@algorithm:digest], adding the implicit
+// docker hub registry and library namespace and the latest tag.
+func ParseReference(image string) (*Reference, error) {
+	ref := &Reference{}
+	name := image
+	if at := strings.Index(image, "@"); at >= 0 {
+		name, ref.Digest = image[:at], image[at+1:]
+		if err := validateDigest(ref.Digest); err != nil {
+			return nil, fmt.Errorf("Invalid image reference %s: %v", image, err)
+		}
+	}
+	if colon := strings.LastIndex(name, ":"); colon > strings.LastIndex(name, "/") {
+		name, ref.Tag = name[:colon], name[colon+1:]
+	}
+	if len(ref.Tag) == 0 && len(ref.Digest) == 0 {
+		ref.Tag = DEFAULT_TAG
+	}
+
+	ref.Registry, ref.Repository = DOCKER_HUB, name
+	if slash := strings.Index(name, "/"); slash >= 0 {
+		if host := name[:slash]; strings.ContainsAny(host, ".:") || host == "localhost" {
+			ref.Registry, ref.Repository = host, name[slash+1:]
+		}
+	}
+	if len(ref.Repository) == 0 || strings.ToLower(ref.Repository) != ref.Repository {
+		return nil, fmt.Errorf("Invalid image reference %s: the repository must be lowercase and not empty", image)
+	}
+	if ref.Registry == DOCKER_HUB_LEGACY {
+		ref.Registry = DOCKER_HUB
+	}
+	if ref.Registry == DOCKER_HUB && !strings.Contains(ref.Repository, "/") {
+		ref.Repository = DOCKER_HUB_LIBRARY + ref.Repository
+	}
+	return ref, nil
+}
+
+// validateDigest checks digest is algorithm:hex.
+func validateDigest(digest string) error {
+	colon := strings.Index(digest, ":")
+	if colon <= 0 || colon == len(digest)-1 {
+		return fmt.Errorf("%s is not a digest", digest)
+	}
+	for _, c := range digest[colon+1:] {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return fmt.Errorf("%s is not a digest", digest)
+		}
+	}
+	return nil
+}
+
+// Name returns the fully qualified name of the repository.
+func (r *Reference) Name() string {
+	return r.Registry + "/" + r.Repository
+}
+
+// String returns the fully qualified reference.
+func (r *Reference) String() string {
+	s := r.Name()
+	if len(r.Tag) > 0 {
+		s += ":" + r.Tag
+	}
+	if len(r.Digest) > 0 {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+// WithDigest returns the reference of digest in the repository of r.
+func (r *Reference) WithDigest(digest string) string {
+	return r.Name() + "@" + digest
+}
+
+// host returns the host serving the registry API of r.
+func (r *Reference) host() string {
+	if r.Registry == DOCKER_HUB {
+		return DOCKER_HUB_API
+	}
+	return r.Registry
+}
+
+// manifestReference is the tag or digest to ask the registry for.
+func (r *Reference) manifestReference() string {
+	if len(r.Digest) > 0 {
+		return r.Digest
+	}
+	return r.Tag
+}
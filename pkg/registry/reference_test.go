@@ -0,0 +1,43 @@
+package registry
+
+import (
+	"testing"
+)
+
+func TestParseReference(t *testing.T) {
+	digest := "sha256:0123456789abcdef"
+	for image, expected := range map[string]string{
+		"fedora":                                 "docker.io/library/fedora:latest",
+		"fedora:26":                              "docker.io/library/fedora:26",
+		"openshift/origin:v3.6":                  "docker.io/openshift/origin:v3.6",
+		"index.docker.io/library/fedora":         "docker.io/library/fedora:latest",
+		"quay.io/coreos/etcd:v3.2":               "quay.io/coreos/etcd:v3.2",
+		"localhost/app":                          "localhost/app:latest",
+		"localhost:5000/ns/app:1.0":              "localhost:5000/ns/app:1.0",
+		"fedora@" + digest:                       "docker.io/library/fedora@" + digest,
+		"registry.example.com/app:1.0@" + digest: "registry.example.com/app:1.0@" + digest,
+	} {
+		ref, err := ParseReference(image)
+		if err != nil {
+			t.Errorf("Unable to parse %s: %v", image, err)
+			continue
+		}
+		if ref.String() != expected {
+			t.Errorf("Expected %s to be parsed as %s but got %s", image, expected, ref)
+		}
+	}
+
+	for _, image := range []string{"", "Fedora", "fedora@sha256", "fedora@sha256:xyz", "quay.io/"} {
+		if _, err := ParseReference(image); err == nil {
+			t.Errorf("Parsing %q should have failed", image)
+		}
+	}
+
+	ref, _ := ParseReference("fedora:26")
+	if ref.host() != DOCKER_HUB_API || ref.manifestReference() != "26" {
+		t.Errorf("Unexpected registry host %s and manifest %s", ref.host(), ref.manifestReference())
+	}
+	if digestRef := ref.WithDigest(digest); digestRef != "docker.io/library/fedora@"+digest {
+		t.Errorf("Unexpected reference by digest %s", digestRef)
+	}
+}
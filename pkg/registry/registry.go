@@ -0,0 +1,310 @@
+package registry
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+const (
+	MEDIA_TYPE_MANIFEST_LIST = "application/vnd.docker.distribution.manifest.list.v2+json"
+	MEDIA_TYPE_MANIFEST      = "application/vnd.docker.distribution.manifest.v2+json"
+	MEDIA_TYPE_OCI_INDEX     = "application/vnd.oci.image.index.v1+json"
+	MEDIA_TYPE_OCI_MANIFEST  = "application/vnd.oci.image.manifest.v1+json"
+	// MAX_MANIFEST_SIZE is the largest manifest read from a registry
+	MAX_MANIFEST_SIZE = 4 * 1024 * 1024
+	REGISTRY_TIMEOUT  = 30 * time.Second
+)
+
+// manifestMediaTypes are the manifests accepted from the registries.
+var manifestMediaTypes = []string{
+	MEDIA_TYPE_MANIFEST_LIST,
+	MEDIA_TYPE_OCI_INDEX,
+	MEDIA_TYPE_MANIFEST,
+	MEDIA_TYPE_OCI_MANIFEST,
+}
+
+// Manifest is a manifest, or manifest list, served by a registry.
+type Manifest struct {
+	MediaType string
+	// Digest is the digest of Content
+	Digest  string
+	Content []byte
+}
+
+// ManifestDescriptor is an entry of a manifest list.
+type ManifestDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+	Platform  struct {
+		OS           string `json:"os"`
+		Architecture string `json:"architecture"`
+		Variant      string `json:"variant"`
+	} `json:"platform"`
+}
+
+// manifestList is a docker manifest list or an OCI image index.
+type manifestList struct {
+	Manifests []ManifestDescriptor `json:"manifests"`
+}
+
+// Client reads the manifests of the images from the registries with the
+// registry API v2.
+type Client struct {
+	auth   docker.AuthConfiguration
+	client *http.Client
+	// scheme is http for tests only, the registries are always reached with https
+	scheme string
+}
+
+// NewClient returns a registry client authenticating with auth when the
+// registry asks for it.
+func NewClient(auth docker.AuthConfiguration) *Client {
+	return &Client{
+		auth:   auth,
+		client: &http.Client{Timeout: REGISTRY_TIMEOUT},
+		scheme: "https",
+	}
+}
+
+// IsManifestList returns true when mediaType is the media type of a
+// manifest list or of an OCI image index.
+func IsManifestList(mediaType string) bool {
+	return mediaType == MEDIA_TYPE_MANIFEST_LIST || mediaType == MEDIA_TYPE_OCI_INDEX
+}
+
+// GetManifest returns the manifest, or manifest list, of ref.
+func (c *Client) GetManifest(ref *Reference) (*Manifest, error) {
+	manifestURL := fmt.Sprintf("%s://%s/v2/%s/manifests/%s", c.scheme, ref.host(), ref.Repository, ref.manifestReference())
+	resp, err := c.get(manifestURL, ref.Repository, strings.Join(manifestMediaTypes, ", "))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get the manifest of %s: %v", ref, err)
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(io.LimitReader(resp.Body, MAX_MANIFEST_SIZE))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read the manifest of %s: %v", ref, err)
+	}
+
+	sum := sha256.Sum256(content)
+	manifest := &Manifest{Digest: "sha256:" + hex.EncodeToString(sum[:]), Content: content}
+	if digest := resp.Header.Get("Docker-Content-Digest"); len(digest) > 0 && digest != manifest.Digest {
+		return nil, fmt.Errorf("The manifest of %s doesn't match its digest %s", ref, digest)
+	}
+	if len(ref.Digest) > 0 && ref.Digest != manifest.Digest {
+		return nil, fmt.Errorf("The manifest of %s doesn't match its digest", ref)
+	}
+	manifest.MediaType, _, _ = mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if len(manifest.MediaType) == 0 {
+		// old registries serving OCI manifests only have it in the content
+		var mediaType struct {
+			MediaType string `json:"mediaType"`
+		}
+		json.Unmarshal(content, &mediaType)
+		manifest.MediaType = mediaType.MediaType
+	}
+	return manifest, nil
+}
+
+// GetBlob returns the blob digest of the repository of ref.
+func (c *Client) GetBlob(ref *Reference, digest string) ([]byte, error) {
+	blobURL := fmt.Sprintf("%s://%s/v2/%s/blobs/%s", c.scheme, ref.host(), ref.Repository, digest)
+	resp, err := c.get(blobURL, ref.Repository, "*/*")
+	if err != nil {
+		return nil, fmt.Errorf("Unable to get the blob %s of %s: %v", digest, ref.Name(), err)
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(io.LimitReader(resp.Body, MAX_MANIFEST_SIZE))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read the blob %s of %s: %v", digest, ref.Name(), err)
+	}
+	if sum := sha256.Sum256(content); digest != "sha256:"+hex.EncodeToString(sum[:]) {
+		return nil, fmt.Errorf("The blob %s of %s doesn't match its digest", digest, ref.Name())
+	}
+	return content, nil
+}
+
+// ConfigDigest returns the digest of the image configuration of the
+// manifest, which is the id of the image, empty for the manifest lists.
+func (m *Manifest) ConfigDigest() (string, error) {
+	if IsManifestList(m.MediaType) {
+		return "", nil
+	}
+	var manifest struct {
+		Config struct {
+			Digest string `json:"digest"`
+		} `json:"config"`
+	}
+	if err := json.Unmarshal(m.Content, &manifest); err != nil {
+		return "", fmt.Errorf("Unable to parse the manifest: %v", err)
+	}
+	if len(manifest.Config.Digest) == 0 {
+		return "", fmt.Errorf("The manifest has no image configuration")
+	}
+	return manifest.Config.Digest, nil
+}
+
+// Platforms returns the entries of the manifest list, skipping those of no
+// platform, such as the attestations. It fails when manifest isn't a list.
+func (m *Manifest) Platforms() ([]ManifestDescriptor, error) {
+	if !IsManifestList(m.MediaType) {
+		return nil, fmt.Errorf("%s is not a manifest list", m.MediaType)
+	}
+	var list manifestList
+	if err := json.Unmarshal(m.Content, &list); err != nil {
+		return nil, fmt.Errorf("Unable to parse the manifest list: %v", err)
+	}
+	descriptors := []ManifestDescriptor{}
+	for _, desc := range list.Manifests {
+		if len(desc.Platform.OS) == 0 || desc.Platform.OS == "unknown" {
+			continue
+		}
+		descriptors = append(descriptors, desc)
+	}
+	return descriptors, nil
+}
+
+// get gets rawURL, authenticating as asked by the registry.
+func (c *Client) get(rawURL, repository, accept string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err = c.authorize(req, challenge, repository); err != nil {
+			return nil, err
+		}
+		if resp, err = c.client.Do(req); err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("registry returned %s", resp.Status)
+	}
+	return resp, nil
+}
+
+// authorize sets the authorization of req answering challenge, asking the
+// token service of the registry for a pull token of repository when needed.
+func (c *Client) authorize(req *http.Request, challenge, repository string) error {
+	scheme, params := parseChallenge(challenge)
+	switch scheme {
+	case "basic":
+		if len(c.auth.Username) == 0 {
+			return fmt.Errorf("registry returned 401 Unauthorized")
+		}
+		req.SetBasicAuth(c.auth.Username, c.auth.Password)
+		return nil
+	case "bearer":
+		token, err := c.token(params, repository)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+	return fmt.Errorf("registry asked for the unsupported authentication %q", challenge)
+}
+
+// token returns a token of the token service described by params.
+func (c *Client) token(params map[string]string, repository string) (string, error) {
+	tokenURL, err := url.Parse(params["realm"])
+	if err != nil || len(params["realm"]) == 0 {
+		return "", fmt.Errorf("Invalid token service %q", params["realm"])
+	}
+	query := tokenURL.Query()
+	if service, ok := params["service"]; ok {
+		query.Set("service", service)
+	}
+	scope := params["scope"]
+	if len(scope) == 0 {
+		scope = "repository:" + repository + ":pull"
+	}
+	query.Set("scope", scope)
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequest("GET", tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if len(c.auth.Username) > 0 {
+		req.SetBasicAuth(c.auth.Username, c.auth.Password)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Unable to get a registry token: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Unable to get a registry token: token service returned %s", resp.Status)
+	}
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, MAX_MANIFEST_SIZE)).Decode(&token); err != nil {
+		return "", fmt.Errorf("Unable to parse the registry token: %v", err)
+	}
+	if len(token.Token) > 0 {
+		return token.Token, nil
+	}
+	if len(token.AccessToken) > 0 {
+		return token.AccessToken, nil
+	}
+	return "", fmt.Errorf("The token service returned no token")
+}
+
+// parseChallenge parses the WWW-Authenticate header challenge, returning its
+// lowercase scheme and its parameters.
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := map[string]string{}
+	challenge = strings.TrimSpace(challenge)
+	space := strings.Index(challenge, " ")
+	if space < 0 {
+		return strings.ToLower(challenge), params
+	}
+	scheme, rest := strings.ToLower(challenge[:space]), challenge[space+1:]
+	for len(rest) > 0 {
+		eq := strings.Index(rest, "=")
+		if eq < 0 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(rest[:eq]))
+		rest = strings.TrimSpace(rest[eq+1:])
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				value, rest = rest[1:], ""
+			} else {
+				value, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else if comma := strings.Index(rest, ","); comma >= 0 {
+			value, rest = rest[:comma], rest[comma:]
+		} else {
+			value, rest = rest, ""
+		}
+		params[key] = value
+		rest = strings.TrimPrefix(strings.TrimSpace(rest), ",")
+	}
+	return scheme, params
+}
@@ -0,0 +1,162 @@
+package registry
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+const manifestListJSON = `{
+  "schemaVersion": 2,
+  "mediaType": "application/vnd.docker.distribution.manifest.list.v2+json",
+  "manifests": [
+    {"mediaType": "application/vnd.docker.distribution.manifest.v2+json", "digest": "sha256:1111", "size": 100,
+     "platform": {"architecture": "amd64", "os": "linux"}},
+    {"mediaType": "application/vnd.docker.distribution.manifest.v2+json", "digest": "sha256:2222", "size": 100,
+     "platform": {"architecture": "arm", "os": "linux", "variant": "v7"}},
+    {"mediaType": "application/vnd.docker.distribution.manifest.v2+json", "digest": "sha256:3333", "size": 100,
+     "platform": {"architecture": "arm64", "os": "linux", "variant": "v8"}},
+    {"mediaType": "application/vnd.oci.image.manifest.v1+json", "digest": "sha256:4444", "size": 100,
+     "platform": {"architecture": "unknown", "os": "unknown"}}
+  ]
+}`
+
+// newFakeRegistry returns a registry serving the manifest list of
+// ns/app:1.0 to the clients with a token of its token service, given to
+// user.
+func newFakeRegistry(t *testing.T) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if r.URL.Query().Get("scope") != "repository:ns/app:pull" || r.URL.Query().Get("service") != "registry" {
+				t.Errorf("Unexpected token request %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"token": "abcd"}`))
+		case "/v2/ns/app/manifests/1.0":
+			if r.Header.Get("Authorization") != "Bearer abcd" {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry",scope="repository:ns/app:pull"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if !strings.Contains(r.Header.Get("Accept"), MEDIA_TYPE_MANIFEST_LIST) {
+				t.Errorf("Unexpected accepted media types %s", r.Header.Get("Accept"))
+			}
+			w.Header().Set("Content-Type", MEDIA_TYPE_MANIFEST_LIST)
+			w.Write([]byte(manifestListJSON))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server
+}
+
+func TestGetManifest(t *testing.T) {
+	server := newFakeRegistry(t)
+	defer server.Close()
+	ref, err := ParseReference(strings.TrimPrefix(server.URL, "http://") + "/ns/app:1.0")
+	if err != nil {
+		t.Fatalf("Unable to parse the reference: %v", err)
+	}
+
+	client := NewClient(docker.AuthConfiguration{Username: "user", Password: "secret"})
+	client.scheme = "http"
+	manifest, err := client.GetManifest(ref)
+	if err != nil {
+		t.Fatalf("Unable to get the manifest: %v", err)
+	}
+	sum := sha256.Sum256([]byte(manifestListJSON))
+	if manifest.MediaType != MEDIA_TYPE_MANIFEST_LIST || manifest.Digest != "sha256:"+hex.EncodeToString(sum[:]) {
+		t.Errorf("Unexpected manifest %s %s", manifest.MediaType, manifest.Digest)
+	}
+	if configDigest, err := manifest.ConfigDigest(); err != nil || len(configDigest) > 0 {
+		t.Errorf("A manifest list should have no configuration but got %q: %v", configDigest, err)
+	}
+	descriptors, err := manifest.Platforms()
+	if err != nil || len(descriptors) != 3 {
+		t.Fatalf("Expected the 3 platforms of the list but got %+v: %v", descriptors, err)
+	}
+
+	for platform, expected := range map[string]string{
+		"linux/amd64":    "sha256:1111",
+		"linux/arm/v7":   "sha256:2222",
+		"linux/arm64":    "sha256:3333",
+		"linux/arm64/v8": "sha256:3333",
+		"linux/arm/v6":   "",
+		"linux/s390x":    "",
+	} {
+		p, err := ParsePlatform(platform)
+		if err != nil {
+			t.Errorf("Unable to parse %s: %v", platform, err)
+			continue
+		}
+		desc, err := MatchPlatform(descriptors, p)
+		if len(expected) == 0 {
+			if err == nil {
+				t.Errorf("Matching %s should have failed", platform)
+			}
+			continue
+		}
+		if err != nil || desc.Digest != expected {
+			t.Errorf("Expected %s to match %s but got %+v: %v", platform, expected, desc, err)
+		}
+	}
+
+	anonymous := NewClient(docker.AuthConfiguration{})
+	anonymous.scheme = "http"
+	if _, err := anonymous.GetManifest(ref); err == nil {
+		t.Errorf("Getting the manifest without credentials should have failed")
+	}
+	ref.Tag = "2.0"
+	if _, err := client.GetManifest(ref); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("Expected a not found error but got %v", err)
+	}
+	ref.Tag, ref.Digest = "1.0", "sha256:1111"
+	if _, err := client.GetManifest(ref); err == nil {
+		t.Errorf("A manifest not matching its digest should have failed")
+	}
+}
+
+func TestParsePlatform(t *testing.T) {
+	for platform, expected := range map[string]*iiapi.Platform{
+		"linux/amd64":     {OS: "linux", Architecture: "amd64"},
+		"Linux/ARM64/v8":  {OS: "linux", Architecture: "arm64", Variant: "v8"},
+		"linux":           nil,
+		"linux/":          nil,
+		"linux/arm/v7/hf": nil,
+	} {
+		p, err := ParsePlatform(platform)
+		if expected == nil {
+			if err == nil {
+				t.Errorf("Parsing %s should have failed", platform)
+			}
+			continue
+		}
+		if err != nil || p != *expected {
+			t.Errorf("Expected %s to be parsed as %+v but got %+v: %v", platform, expected, p, err)
+		}
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/fedora:pull"`)
+	if scheme != "bearer" || params["realm"] != "https://auth.docker.io/token" ||
+		params["service"] != "registry.docker.io" || params["scope"] != "repository:library/fedora:pull" {
+		t.Errorf("Unexpected challenge %s %v", scheme, params)
+	}
+	if scheme, params = parseChallenge(`Basic realm=registry`); scheme != "basic" || params["realm"] != "registry" {
+		t.Errorf("Unexpected challenge %s %v", scheme, params)
+	}
+}
@@ -0,0 +1,218 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+const (
+	CRICTL = "crictl"
+	CTR    = "ctr"
+	// CRICTL_AUTH is the environment variable crictl reads the base64
+	// USERNAME:PASSWORD of the registry from, which unlike its command line
+	// other users can't read
+	CRICTL_AUTH = "CRICTL_AUTH"
+	// CONTAINERD_NAMESPACE is the containerd namespace of the images of the
+	// CRI plugin
+	CONTAINERD_NAMESPACE = "k8s.io"
+)
+
+// criRuntime pulls and inspects the images through the image service of
+// the CRI runtime of the node with crictl. The CRI has no way to export an
+// image, they are exported from containerd with ctr.
+type criRuntime struct {
+	endpoint string
+	crictl   string
+	ctr      string
+}
+
+// ensures this always implements the interface or fail compilation.
+var _ Runtime = &criRuntime{}
+
+// criHistory is an entry of the history of an OCI image configuration.
+type criHistory struct {
+	Created    time.Time `json:"created"`
+	CreatedBy  string    `json:"created_by"`
+	Comment    string    `json:"comment"`
+	EmptyLayer bool      `json:"empty_layer"`
+}
+
+// criImageSpec is the OCI image configuration of the verbose image status.
+type criImageSpec struct {
+	Created      time.Time       `json:"created"`
+	Author       string          `json:"author"`
+	Architecture string          `json:"architecture"`
+	Config       *ociImageConfig `json:"config"`
+	History      []criHistory    `json:"history"`
+}
+
+// criImageStatus is the output of crictl inspecti.
+type criImageStatus struct {
+	Status struct {
+		ID          string      `json:"id"`
+		RepoTags    []string    `json:"repoTags"`
+		RepoDigests []string    `json:"repoDigests"`
+		Size        json.Number `json:"size"`
+	} `json:"status"`
+	Info struct {
+		ImageSpec *criImageSpec `json:"imageSpec"`
+	} `json:"info"`
+}
+
+func newCRIRuntime(endpoint string) Runtime {
+	return &criRuntime{endpoint: endpoint, crictl: CRICTL, ctr: CTR}
+}
+
+func (r *criRuntime) Name() string {
+	return RUNTIME_CRI
+}
+
+// run runs name with args writing its output to stdout, the errors include
+// what it wrote on stderr.
+func run(stdout io.Writer, name string, args ...string) error {
+	return runWithEnv(stdout, nil, name, args...)
+}
+
+// runWithEnv runs name like run, adding env to its environment.
+func runWithEnv(stdout io.Writer, env []string, name string, args ...string) error {
+	var stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s %s failed: %v: %s", name, args[len(args)-1], err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// crictlRun runs crictl with args against the endpoint of the runtime.
+func (r *criRuntime) crictlRun(stdout io.Writer, args ...string) error {
+	return r.crictlRunWithEnv(stdout, nil, args...)
+}
+
+// crictlRunWithEnv runs crictl like crictlRun, adding env to its environment.
+func (r *criRuntime) crictlRunWithEnv(stdout io.Writer, env []string, args ...string) error {
+	return runWithEnv(stdout, env, r.crictl, append([]string{"--runtime-endpoint", r.endpoint, "--image-endpoint", r.endpoint}, args...)...)
+}
+
+// PullImage pulls image with crictl, passing the credentials of auth in its
+// environment, never on its command line.
+func (r *criRuntime) PullImage(image string, auth docker.AuthConfiguration, output io.Writer) error {
+	var env []string
+	if len(auth.Username) > 0 {
+		env = append(env, CRICTL_AUTH+"="+base64.StdEncoding.EncodeToString([]byte(auth.Username+":"+auth.Password)))
+	}
+	var out bytes.Buffer
+	if err := r.crictlRunWithEnv(&out, env, "pull", image); err != nil {
+		return err
+	}
+	if status := strings.TrimSpace(out.String()); len(status) > 0 {
+		writeStatus(output, status)
+	}
+	return nil
+}
+
+// imageStatus returns the verbose status of image.
+func (r *criRuntime) imageStatus(image string) (*criImageStatus, error) {
+	var out bytes.Buffer
+	if err := r.crictlRun(&out, "inspecti", "-o", "json", image); err != nil {
+		return nil, err
+	}
+	var status criImageStatus
+	if err := json.Unmarshal(out.Bytes(), &status); err != nil {
+		return nil, fmt.Errorf("Unable to parse the status of %s: %v", image, err)
+	}
+	return &status, nil
+}
+
+func (r *criRuntime) InspectImage(image string) (*docker.Image, error) {
+	status, err := r.imageStatus(image)
+	if err != nil {
+		return nil, err
+	}
+	size, _ := status.Status.Size.Int64()
+	meta := &docker.Image{
+		ID:          imageID(status.Status.ID),
+		RepoTags:    status.Status.RepoTags,
+		RepoDigests: status.Status.RepoDigests,
+		Size:        size,
+		VirtualSize: size,
+	}
+	if spec := status.Info.ImageSpec; spec != nil {
+		meta.Created = spec.Created
+		meta.Author = spec.Author
+		meta.Architecture = spec.Architecture
+		meta.Config = spec.Config.dockerConfig()
+	}
+	return meta, nil
+}
+
+// ImageHistory returns the history of the configuration of image, the CRI
+// doesn't know the layers of the entries.
+func (r *criRuntime) ImageHistory(image string) ([]docker.ImageHistory, error) {
+	status, err := r.imageStatus(image)
+	if err != nil {
+		return nil, err
+	}
+	history := []docker.ImageHistory{}
+	if status.Info.ImageSpec == nil {
+		return history, nil
+	}
+	entries := status.Info.ImageSpec.History
+	for idx := len(entries) - 1; idx >= 0; idx-- {
+		history = append(history, docker.ImageHistory{
+			ID:        "<missing>",
+			Created:   entries[idx].Created.Unix(),
+			CreatedBy: entries[idx].CreatedBy,
+		})
+	}
+	return history, nil
+}
+
+// ExportImage exports image from containerd, as an OCI archive with a
+// docker manifest.json. The images of CRI-O can't be exported.
+func (r *criRuntime) ExportImage(image string, output io.Writer) error {
+	status, err := r.imageStatus(image)
+	if err != nil {
+		return err
+	}
+	// containerd knows the images by their full names
+	refs := append(status.Status.RepoTags, status.Status.RepoDigests...)
+	if len(refs) == 0 {
+		return fmt.Errorf("Unable to export %s without a name", image)
+	}
+	return run(output, r.ctr, "--address", strings.TrimPrefix(r.endpoint, "unix://"),
+		"--namespace", CONTAINERD_NAMESPACE, "images", "export", "-", refs[0])
+}
+
+// ExportRootfs isn't supported, the CRI images are extracted layer by layer.
+func (r *criRuntime) ExportRootfs(image string, output io.Writer) error {
+	return fmt.Errorf("The %s runtime can't export the filesystem of %s, please extract it layer by layer", RUNTIME_CRI, image)
+}
+
+// InspectContainer isn't supported, the CRI containers can't be exported.
+func (r *criRuntime) InspectContainer(id string) (*docker.Container, error) {
+	return nil, fmt.Errorf("The %s runtime can't inspect the container %s", RUNTIME_CRI, id)
+}
+
+// ContainerChanges isn't supported, the CRI containers can't be exported.
+func (r *criRuntime) ContainerChanges(id string) ([]docker.Change, error) {
+	return nil, fmt.Errorf("The %s runtime can't inspect the container %s", RUNTIME_CRI, id)
+}
+
+// ExportContainer isn't supported, the CRI has no way to export a container.
+func (r *criRuntime) ExportContainer(id string, output io.Writer) error {
+	return fmt.Errorf("The %s runtime can't export the container %s", RUNTIME_CRI, id)
+}
@@ -0,0 +1,112 @@
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+const criImageStatusJSON = `{
+  "status": {
+    "id": "sha256:2222",
+    "repoTags": ["docker.io/library/fedora:26"],
+    "repoDigests": ["docker.io/library/fedora@sha256:3333"],
+    "size": "1024"
+  },
+  "info": {
+    "imageSpec": {
+      "created": "2017-07-14T02:40:00Z",
+      "architecture": "s390x",
+      "config": {"Env": ["PATH=/usr/bin"], "Cmd": ["/bin/bash"]},
+      "history": [
+        {"created": "2017-07-14T02:39:00Z", "created_by": "ADD rootfs.tar /"},
+        {"created": "2017-07-14T02:40:00Z", "created_by": "CMD [\"/bin/bash\"]", "empty_layer": true}
+      ]
+    }
+  }
+}`
+
+// fakeCommand writes a script named name in dir logging its arguments to
+// log and running script.
+func fakeCommand(t *testing.T, dir, name, script string) string {
+	fileName := path.Join(dir, name)
+	content := fmt.Sprintf("#!/bin/sh\necho \"%s $@\" >> %s\n%s\n", name, path.Join(dir, "log"), script)
+	if err := ioutil.WriteFile(fileName, []byte(content), 0755); err != nil {
+		t.Fatalf("Unable to write %s: %v", fileName, err)
+	}
+	return fileName
+}
+
+func TestCRIRuntime(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cri-runtime-")
+	if err != nil {
+		t.Fatalf("Unable to create the temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	statusFile := path.Join(dir, "status.json")
+	if err := ioutil.WriteFile(statusFile, []byte(criImageStatusJSON), 0644); err != nil {
+		t.Fatalf("Unable to write the image status: %v", err)
+	}
+
+	rt := &criRuntime{
+		endpoint: "unix:///run/containerd/containerd.sock",
+		crictl: fakeCommand(t, dir, "crictl", `case "$5" in
+pull) [ "$CRICTL_AUTH" = "dXNlcjpzZWNyZXQ=" ] && echo "Image is up to date for sha256:2222" || { echo "unauthorized" >&2; exit 1; } ;;
+inspecti) cat `+statusFile+` ;;
+esac`),
+		ctr: fakeCommand(t, dir, "ctr", `echo -n archive`),
+	}
+
+	output := &bytes.Buffer{}
+	if err := rt.PullImage("fedora:26", docker.AuthConfiguration{Username: "user", Password: "secret"}, output); err != nil {
+		t.Errorf("Unable to pull: %v", err)
+	}
+	if output.String() != "{\"status\":\"Image is up to date for sha256:2222\"}\n" {
+		t.Errorf("Unexpected pull messages %q", output.String())
+	}
+	if err := rt.PullImage("fedora:26", docker.AuthConfiguration{}, output); err == nil || !strings.Contains(err.Error(), "unauthorized") {
+		t.Errorf("Expected the pull error to be reported but got %v", err)
+	}
+
+	image, err := rt.InspectImage("fedora:26")
+	if err != nil {
+		t.Fatalf("Unable to inspect: %v", err)
+	}
+	if image.ID != "sha256:2222" || image.Size != 1024 || image.Architecture != "s390x" ||
+		image.Config == nil || image.Config.Cmd[0] != "/bin/bash" || image.Created.Year() != 2017 {
+		t.Errorf("Unexpected image %+v", image)
+	}
+
+	history, err := rt.ImageHistory("fedora:26")
+	if err != nil || len(history) != 2 || history[1].CreatedBy != "ADD rootfs.tar /" {
+		t.Errorf("Unexpected history %+v: %v", history, err)
+	}
+
+	archive := &bytes.Buffer{}
+	if err := rt.ExportImage("fedora:26", archive); err != nil || archive.String() != "archive" {
+		t.Errorf("Unexpected export %q: %v", archive.String(), err)
+	}
+	if err := rt.ExportRootfs("fedora:26", archive); err == nil {
+		t.Errorf("Exporting the filesystem should have failed")
+	}
+
+	log, _ := ioutil.ReadFile(path.Join(dir, "log"))
+	endpoints := "--runtime-endpoint unix:///run/containerd/containerd.sock --image-endpoint unix:///run/containerd/containerd.sock"
+	expected := []string{
+		"crictl " + endpoints + " pull fedora:26",
+		"crictl " + endpoints + " pull fedora:26",
+		"crictl " + endpoints + " inspecti -o json fedora:26",
+		"crictl " + endpoints + " inspecti -o json fedora:26",
+		"crictl " + endpoints + " inspecti -o json fedora:26",
+		"ctr --address /run/containerd/containerd.sock --namespace k8s.io images export - docker.io/library/fedora:26",
+	}
+	if commands := strings.Split(strings.TrimSpace(string(log)), "\n"); strings.Join(commands, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expected the commands\n%s\nbut got\n%s", strings.Join(expected, "\n"), strings.Join(commands, "\n"))
+	}
+}
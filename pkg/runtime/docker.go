@@ -0,0 +1,98 @@
+package runtime
+
+import (
+	"fmt"
+	"io"
+
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+// dockerRuntime talks to the docker daemon with go-dockerclient.
+type dockerRuntime struct {
+	client *docker.Client
+}
+
+// ensures this always implements the interface or fail compilation.
+var _ Runtime = &dockerRuntime{}
+
+func newDockerRuntime(endpoint string) (Runtime, error) {
+	client, err := docker.NewClient(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to connect to docker daemon: %v\n", err)
+	}
+	return &dockerRuntime{client: client}, nil
+}
+
+func (r *dockerRuntime) Name() string {
+	return RUNTIME_DOCKER
+}
+
+func (r *dockerRuntime) PullImage(image string, auth docker.AuthConfiguration, output io.Writer) error {
+	return r.client.PullImage(docker.PullImageOptions{
+		Repository:    image,
+		OutputStream:  output,
+		RawJSONStream: true,
+	}, auth)
+}
+
+func (r *dockerRuntime) InspectImage(image string) (*docker.Image, error) {
+	return r.client.InspectImage(image)
+}
+
+func (r *dockerRuntime) ImageHistory(image string) ([]docker.ImageHistory, error) {
+	return r.client.ImageHistory(image)
+}
+
+func (r *dockerRuntime) ExportImage(image string, output io.Writer) error {
+	return r.client.ExportImage(docker.ExportImageOptions{
+		Name:         image,
+		OutputStream: output,
+	})
+}
+
+// ExportRootfs creates a container of image, never started, and downloads
+// its filesystem.
+func (r *dockerRuntime) ExportRootfs(image string, output io.Writer) error {
+	name, err := generateRandomName()
+	if err != nil {
+		return err
+	}
+	container, err := r.client.CreateContainer(docker.CreateContainerOptions{
+		Name: name,
+		Config: &docker.Config{
+			Image: image,
+			// For security purpose we don't define any entrypoint and command
+			Entrypoint: []string{""},
+			Cmd:        []string{""},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Unable to create docker container: %v\n", err)
+	}
+
+	// delete the container when we are done extracting it
+	defer func() {
+		r.client.RemoveContainer(docker.RemoveContainerOptions{
+			ID: container.ID,
+		})
+	}()
+
+	return r.ExportContainer(container.ID, output)
+}
+
+func (r *dockerRuntime) InspectContainer(id string) (*docker.Container, error) {
+	return r.client.InspectContainer(id)
+}
+
+func (r *dockerRuntime) ContainerChanges(id string) ([]docker.Change, error) {
+	return r.client.ContainerChanges(id)
+}
+
+func (r *dockerRuntime) ExportContainer(id string, output io.Writer) error {
+	return r.client.DownloadFromContainer(
+		id,
+		docker.DownloadFromContainerOptions{
+			OutputStream: output,
+			Path:         "/",
+		})
+}
@@ -0,0 +1,320 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+const (
+	// PODMAN_API_PATH is the prefix of the paths of the libpod REST API
+	PODMAN_API_PATH = "/v4.0.0/libpod"
+	// MAX_ERROR_SIZE is the maximum size of the error responses read
+	MAX_ERROR_SIZE = 64 * 1024
+)
+
+// podmanRuntime talks to the libpod REST API of a podman service, started
+// with podman system service.
+type podmanRuntime struct {
+	baseURL string
+	client  *http.Client
+}
+
+// ensures this always implements the interface or fail compilation.
+var _ Runtime = &podmanRuntime{}
+
+// podmanError is the body of the error responses of the libpod API.
+type podmanError struct {
+	Cause    string `json:"cause"`
+	Message  string `json:"message"`
+	Response int    `json:"response"`
+}
+
+// podmanPullReport is a message of the stream of an image pull.
+type podmanPullReport struct {
+	Stream string   `json:"stream,omitempty"`
+	Error  string   `json:"error,omitempty"`
+	Images []string `json:"images,omitempty"`
+	ID     string   `json:"id,omitempty"`
+}
+
+// podmanImage holds the parts of the image data of libpod we care about.
+type podmanImage struct {
+	ID           string          `json:"Id"`
+	RepoTags     []string        `json:"RepoTags"`
+	RepoDigests  []string        `json:"RepoDigests"`
+	Parent       string          `json:"Parent"`
+	Comment      string          `json:"Comment"`
+	Created      time.Time       `json:"Created"`
+	Config       *ociImageConfig `json:"Config"`
+	Version      string          `json:"Version"`
+	Author       string          `json:"Author"`
+	Architecture string          `json:"Architecture"`
+	Size         int64           `json:"Size"`
+	VirtualSize  int64           `json:"VirtualSize"`
+}
+
+// podmanContainer holds the parts of the container data of libpod we care
+// about.
+type podmanContainer struct {
+	ID        string       `json:"Id"`
+	Created   time.Time    `json:"Created"`
+	Path      string       `json:"Path"`
+	Args      []string     `json:"Args"`
+	State     docker.State `json:"State"`
+	Image     string       `json:"Image"`
+	ImageName string       `json:"ImageName"`
+	Name      string       `json:"Name"`
+	Config    *struct {
+		ociImageConfig
+		Hostname string `json:"Hostname"`
+	} `json:"Config"`
+}
+
+// podmanContainerCreate is the spec of a container created by the inspector.
+type podmanContainerCreate struct {
+	Name       string   `json:"name"`
+	Image      string   `json:"image"`
+	Entrypoint []string `json:"entrypoint"`
+	Command    []string `json:"command"`
+}
+
+func newPodmanRuntime(endpoint string) (Runtime, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to parse the podman endpoint %s: %v\n", endpoint, err)
+	}
+	r := &podmanRuntime{client: &http.Client{}}
+	switch u.Scheme {
+	case "unix":
+		socket := u.Path
+		r.baseURL = "http://podman"
+		r.client.Transport = &http.Transport{
+			Dial: func(network, addr string) (net.Conn, error) {
+				return net.Dial("unix", socket)
+			},
+		}
+	case "tcp", "http":
+		r.baseURL = "http://" + u.Host
+	case "https":
+		r.baseURL = "https://" + u.Host
+	default:
+		return nil, fmt.Errorf("Unable to connect to podman: unsupported endpoint %s\n", endpoint)
+	}
+	return r, nil
+}
+
+func (r *podmanRuntime) Name() string {
+	return RUNTIME_PODMAN
+}
+
+// request sends a request to the libpod API returning the response when
+// successful, the caller closes its body.
+func (r *podmanRuntime) request(method, path string, query url.Values, header http.Header, body interface{}) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+	u := r.baseURL + PODMAN_API_PATH + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(method, u, reader)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	content, _ := ioutil.ReadAll(io.LimitReader(resp.Body, MAX_ERROR_SIZE))
+	var status podmanError
+	if err := json.Unmarshal(content, &status); err != nil || len(status.Message) == 0 {
+		status.Message = strings.TrimSpace(string(content))
+	}
+	return nil, fmt.Errorf("podman API returned %d: %s", resp.StatusCode, status.Message)
+}
+
+// do sends a request to the libpod API decoding the response in out, when
+// not nil.
+func (r *podmanRuntime) do(method, path string, query url.Values, body, out interface{}) error {
+	resp, err := r.request(method, path, query, nil, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// download writes the body of the response to a GET of path to output.
+func (r *podmanRuntime) download(path string, query url.Values, output io.Writer) error {
+	resp, err := r.request("GET", path, query, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, err = io.Copy(output, resp.Body)
+	return err
+}
+
+// PullImage pulls image, translating the pull reports to docker messages.
+// libpod doesn't report the progress of the downloads.
+func (r *podmanRuntime) PullImage(image string, auth docker.AuthConfiguration, output io.Writer) error {
+	header := http.Header{}
+	if encoded := encodeAuth(auth); len(encoded) > 0 {
+		header.Set("X-Registry-Auth", encoded)
+	}
+	resp, err := r.request("POST", "/images/pull", url.Values{"reference": {image}}, header, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var report podmanPullReport
+		if err := decoder.Decode(&report); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("Unable to read the pull reports: %v", err)
+		}
+		if len(report.Error) > 0 {
+			return fmt.Errorf("%s", report.Error)
+		}
+		if status := strings.TrimSpace(report.Stream); len(status) > 0 {
+			writeStatus(output, status)
+		}
+	}
+}
+
+func (r *podmanRuntime) InspectImage(image string) (*docker.Image, error) {
+	var data podmanImage
+	if err := r.do("GET", "/images/"+url.PathEscape(image)+"/json", nil, nil, &data); err != nil {
+		return nil, err
+	}
+	meta := &docker.Image{
+		ID:            imageID(data.ID),
+		RepoTags:      data.RepoTags,
+		RepoDigests:   data.RepoDigests,
+		Parent:        data.Parent,
+		Comment:       data.Comment,
+		Created:       data.Created,
+		DockerVersion: data.Version,
+		Author:        data.Author,
+		Architecture:  data.Architecture,
+		Size:          data.Size,
+		VirtualSize:   data.VirtualSize,
+	}
+	meta.Config = data.Config.dockerConfig()
+	return meta, nil
+}
+
+func (r *podmanRuntime) ImageHistory(image string) ([]docker.ImageHistory, error) {
+	var history []docker.ImageHistory
+	if err := r.do("GET", "/images/"+url.PathEscape(image)+"/history", nil, nil, &history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func (r *podmanRuntime) ExportImage(image string, output io.Writer) error {
+	return r.download("/images/"+url.PathEscape(image)+"/get", url.Values{"format": {"docker-archive"}}, output)
+}
+
+// ExportRootfs creates a container of image, never started, and exports
+// its filesystem.
+func (r *podmanRuntime) ExportRootfs(image string, output io.Writer) error {
+	name, err := generateRandomName()
+	if err != nil {
+		return err
+	}
+	var created struct {
+		ID string `json:"Id"`
+	}
+	err = r.do("POST", "/containers/create", nil, podmanContainerCreate{
+		Name:  name,
+		Image: image,
+		// For security purpose we don't define any entrypoint and command
+		Entrypoint: []string{""},
+		Command:    []string{""},
+	}, &created)
+	if err != nil {
+		return fmt.Errorf("Unable to create podman container: %v\n", err)
+	}
+
+	// delete the container when we are done extracting it
+	defer func() {
+		r.do("DELETE", "/containers/"+created.ID, url.Values{"force": {"true"}}, nil, nil)
+	}()
+
+	return r.ExportContainer(created.ID, output)
+}
+
+func (r *podmanRuntime) InspectContainer(id string) (*docker.Container, error) {
+	var data podmanContainer
+	if err := r.do("GET", "/containers/"+url.PathEscape(id)+"/json", nil, nil, &data); err != nil {
+		return nil, err
+	}
+	container := &docker.Container{
+		ID:      data.ID,
+		Created: data.Created,
+		Path:    data.Path,
+		Args:    data.Args,
+		State:   data.State,
+		Image:   imageID(data.Image),
+		Name:    data.Name,
+		Config:  &docker.Config{},
+	}
+	if data.Config != nil {
+		container.Config = data.Config.dockerConfig()
+		container.Config.Hostname = data.Config.Hostname
+	}
+	container.Config.Image = data.ImageName
+	return container, nil
+}
+
+func (r *podmanRuntime) ContainerChanges(id string) ([]docker.Change, error) {
+	var changes []docker.Change
+	if err := r.do("GET", "/containers/"+url.PathEscape(id)+"/changes", nil, nil, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func (r *podmanRuntime) ExportContainer(id string, output io.Writer) error {
+	return r.download("/containers/"+url.PathEscape(id)+"/export", nil, output)
+}
+
+// imageID returns id with its algorithm, which libpod leaves out.
+func imageID(id string) string {
+	if len(id) == 0 || strings.Contains(id, ":") {
+		return id
+	}
+	return "sha256:" + id
+}
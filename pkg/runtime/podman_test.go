@@ -0,0 +1,178 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	docker "github.com/fsouza/go-dockerclient"
+)
+
+// newFakePodman returns a libpod API server knowing docker.io/library/fedora
+// and recording the requests it gets.
+func newFakePodman(t *testing.T, requests *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.Method+" "+r.URL.RequestURI())
+		encoder := json.NewEncoder(w)
+		switch r.Method + " " + strings.TrimPrefix(r.URL.EscapedPath(), PODMAN_API_PATH) {
+		case "POST /images/pull":
+			if r.URL.Query().Get("reference") != "fedora:26" {
+				encoder.Encode(podmanPullReport{Error: "manifest unknown"})
+				return
+			}
+			if auth := r.Header.Get("X-Registry-Auth"); len(auth) > 0 {
+				decoded, _ := base64.URLEncoding.DecodeString(auth)
+				if !strings.Contains(string(decoded), `"username":"user"`) {
+					t.Errorf("Unexpected credentials %s", decoded)
+				}
+			}
+			encoder.Encode(podmanPullReport{Stream: "Copying blob 1111\n"})
+			encoder.Encode(podmanPullReport{Images: []string{"2222"}, ID: "2222"})
+		case "GET /images/fedora:26/json":
+			w.Write([]byte(`{"Id": "2222", "RepoTags": ["docker.io/library/fedora:26"],
+				"RepoDigests": ["docker.io/library/fedora@sha256:3333"], "Architecture": "arm64",
+				"Size": 1024, "Config": {"Env": ["PATH=/usr/bin"], "Cmd": ["/bin/bash"],
+				"ExposedPorts": {"8080/tcp": {}}, "Labels": {"name": "fedora"}}}`))
+		case "GET /images/fedora:26/history":
+			w.Write([]byte(`[{"Id": "2222", "Created": 1500000000, "CreatedBy": "ADD rootfs.tar /", "Size": 1024}]`))
+		case "GET /images/fedora:26/get":
+			if r.URL.Query().Get("format") != "docker-archive" {
+				t.Errorf("Unexpected export format %s", r.URL.Query().Get("format"))
+			}
+			w.Write([]byte("archive"))
+		case "POST /containers/create":
+			var spec podmanContainerCreate
+			json.NewDecoder(r.Body).Decode(&spec)
+			if spec.Image != "fedora:26" || !strings.HasPrefix(spec.Name, "image-inspector-") {
+				t.Errorf("Unexpected container %+v", spec)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"Id": "4444"}`))
+		case "GET /containers/4444/export":
+			w.Write([]byte("rootfs"))
+		case "DELETE /containers/4444":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /containers/web/json":
+			w.Write([]byte(`{"Id": "5555", "Name": "web", "Image": "2222", "ImageName": "docker.io/library/fedora:26",
+				"State": {"Status": "running", "Running": true, "Pid": 42, "StartedAt": "2017-07-14T02:40:00Z"},
+				"Config": {"Hostname": "5555", "Cmd": ["/bin/bash"]}}`))
+		case "GET /containers/web/changes":
+			w.Write([]byte(`[{"Path": "/tmp", "Kind": 0}, {"Path": "/tmp/dropped", "Kind": 1}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"cause": "no such image", "message": "failed to find image", "response": 404}`))
+		}
+	}))
+}
+
+func TestPodmanRuntime(t *testing.T) {
+	requests := []string{}
+	server := newFakePodman(t, &requests)
+	defer server.Close()
+	rt, err := NewRuntime(RUNTIME_PODMAN, strings.Replace(server.URL, "http://", "tcp://", 1))
+	if err != nil {
+		t.Fatalf("Unable to create the podman runtime: %v", err)
+	}
+
+	output := &bytes.Buffer{}
+	if err := rt.PullImage("fedora:26", docker.AuthConfiguration{Username: "user", Password: "secret"}, output); err != nil {
+		t.Errorf("Unable to pull: %v", err)
+	}
+	if output.String() != "{\"status\":\"Copying blob 1111\"}\n" {
+		t.Errorf("Unexpected pull messages %q", output.String())
+	}
+	if err := rt.PullImage("fedora:rawhide", docker.AuthConfiguration{}, output); err == nil || err.Error() != "manifest unknown" {
+		t.Errorf("Expected the pull error to be reported but got %v", err)
+	}
+
+	image, err := rt.InspectImage("fedora:26")
+	if err != nil {
+		t.Fatalf("Unable to inspect: %v", err)
+	}
+	if image.ID != "sha256:2222" || image.Architecture != "arm64" || image.RepoDigests[0] != "docker.io/library/fedora@sha256:3333" ||
+		image.Config == nil || image.Config.Cmd[0] != "/bin/bash" || image.Config.Labels["name"] != "fedora" {
+		t.Errorf("Unexpected image %+v", image)
+	}
+	if _, ok := image.Config.ExposedPorts["8080/tcp"]; !ok {
+		t.Errorf("Unexpected exposed ports %v", image.Config.ExposedPorts)
+	}
+	if _, err := rt.InspectImage("centos:7"); err == nil || err.Error() != "podman API returned 404: failed to find image" {
+		t.Errorf("Expected a not found error but got %v", err)
+	}
+
+	history, err := rt.ImageHistory("fedora:26")
+	if err != nil || len(history) != 1 || history[0].CreatedBy != "ADD rootfs.tar /" || history[0].Created != 1500000000 {
+		t.Errorf("Unexpected history %+v: %v", history, err)
+	}
+
+	archive := &bytes.Buffer{}
+	if err := rt.ExportImage("fedora:26", archive); err != nil || archive.String() != "archive" {
+		t.Errorf("Unexpected export %q: %v", archive.String(), err)
+	}
+
+	requests = requests[:0]
+	rootfs := &bytes.Buffer{}
+	if err := rt.ExportRootfs("fedora:26", rootfs); err != nil || rootfs.String() != "rootfs" {
+		t.Errorf("Unexpected rootfs %q: %v", rootfs.String(), err)
+	}
+	expected := []string{
+		"POST " + PODMAN_API_PATH + "/containers/create",
+		"GET " + PODMAN_API_PATH + "/containers/4444/export",
+		"DELETE " + PODMAN_API_PATH + "/containers/4444?force=true",
+	}
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected the requests %v but got %v", expected, requests)
+	}
+}
+
+func TestPodmanContainers(t *testing.T) {
+	requests := []string{}
+	server := newFakePodman(t, &requests)
+	defer server.Close()
+	rt, err := NewRuntime(RUNTIME_PODMAN, strings.Replace(server.URL, "http://", "tcp://", 1))
+	if err != nil {
+		t.Fatalf("Unable to create the podman runtime: %v", err)
+	}
+
+	container, err := rt.InspectContainer("web")
+	if err != nil {
+		t.Fatalf("Unable to inspect the container: %v", err)
+	}
+	if container.ID != "5555" || container.Image != "sha256:2222" || container.Config.Image != "docker.io/library/fedora:26" ||
+		container.Config.Hostname != "5555" || !container.State.Running || container.State.Pid != 42 || container.State.StartedAt.Year() != 2017 {
+		t.Errorf("Unexpected container %+v", container)
+	}
+	if _, err := rt.InspectContainer("db"); err == nil {
+		t.Errorf("Inspecting an unknown container should have failed")
+	}
+
+	changes, err := rt.ContainerChanges("web")
+	if err != nil || len(changes) != 2 || changes[1].Path != "/tmp/dropped" || changes[1].Kind != docker.ChangeAdd {
+		t.Errorf("Unexpected changes %+v: %v", changes, err)
+	}
+}
+
+func TestNewRuntime(t *testing.T) {
+	for name, shouldFail := range map[string]bool{
+		RUNTIME_DOCKER: false,
+		RUNTIME_PODMAN: false,
+		RUNTIME_CRI:    false,
+		"rkt":          true,
+	} {
+		rt, err := NewRuntime(name, "")
+		if shouldFail != (err != nil) {
+			t.Errorf("Creating the %s runtime: unexpected error %v", name, err)
+			continue
+		}
+		if err == nil && rt.Name() != name {
+			t.Errorf("Expected the %s runtime but got %s", name, rt.Name())
+		}
+	}
+	if _, err := NewRuntime(RUNTIME_PODMAN, "ssh://host"); err == nil {
+		t.Errorf("An unsupported podman endpoint should have failed")
+	}
+}
@@ -0,0 +1,136 @@
+package runtime
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+const (
+	RUNTIME_DOCKER = "docker"
+	RUNTIME_PODMAN = "podman"
+	RUNTIME_CRI    = "cri"
+
+	DEFAULT_DOCKER_ENDPOINT = "unix:///var/run/docker.sock"
+	DEFAULT_PODMAN_ENDPOINT = "unix:///run/podman/podman.sock"
+	DEFAULT_CRI_ENDPOINT    = "unix:///run/containerd/containerd.sock"
+)
+
+// Runtime is the container runtime the images are pulled with and exported
+// from.
+type Runtime interface {
+	// Name returns the name of the runtime, one of iiapi.RuntimeOptions.
+	Name() string
+	// PullImage pulls image with auth, writing the progress to output as
+	// a stream of docker JSON messages.
+	PullImage(image string, auth docker.AuthConfiguration, output io.Writer) error
+	// InspectImage returns the metadata of the local image.
+	InspectImage(image string) (*docker.Image, error)
+	// ImageHistory returns the history of the local image, newest first.
+	ImageHistory(image string) ([]docker.ImageHistory, error)
+	// ExportImage writes the local image to output as a docker save
+	// tarball, or an OCI archive with a docker manifest.json.
+	ExportImage(image string, output io.Writer) error
+	// ExportRootfs writes the filesystem of the local image to output as a
+	// tarball.
+	ExportRootfs(image string, output io.Writer) error
+	// InspectContainer returns the metadata of the container id.
+	InspectContainer(id string) (*docker.Container, error)
+	// ContainerChanges returns the paths of the container id changed from
+	// its image.
+	ContainerChanges(id string) ([]docker.Change, error)
+	// ExportContainer writes the filesystem of the container id, running or
+	// not, to output as a tarball.
+	ExportContainer(id string, output io.Writer) error
+}
+
+// ociImageConfig holds the parts of the OCI configuration of an image we
+// care about, as reported by libpod and CRI.
+type ociImageConfig struct {
+	User         string              `json:"User,omitempty"`
+	ExposedPorts map[string]struct{} `json:"ExposedPorts,omitempty"`
+	Env          []string            `json:"Env,omitempty"`
+	Entrypoint   []string            `json:"Entrypoint,omitempty"`
+	Cmd          []string            `json:"Cmd,omitempty"`
+	Volumes      map[string]struct{} `json:"Volumes,omitempty"`
+	WorkingDir   string              `json:"WorkingDir,omitempty"`
+	Labels       map[string]string   `json:"Labels,omitempty"`
+	StopSignal   string              `json:"StopSignal,omitempty"`
+}
+
+// dockerConfig returns c as the configuration of a docker image.
+func (c *ociImageConfig) dockerConfig() *docker.Config {
+	if c == nil {
+		return nil
+	}
+	config := &docker.Config{
+		User:         c.User,
+		Env:          c.Env,
+		Entrypoint:   c.Entrypoint,
+		Cmd:          c.Cmd,
+		Volumes:      c.Volumes,
+		WorkingDir:   c.WorkingDir,
+		Labels:       c.Labels,
+		StopSignal:   c.StopSignal,
+		ExposedPorts: map[docker.Port]struct{}{},
+	}
+	for port := range c.ExposedPorts {
+		config.ExposedPorts[docker.Port(port)] = struct{}{}
+	}
+	return config
+}
+
+// NewRuntime returns the runtime name, one of iiapi.RuntimeOptions, reached
+// at endpoint, the default endpoint of the runtime when empty.
+func NewRuntime(name, endpoint string) (Runtime, error) {
+	switch name {
+	case RUNTIME_DOCKER:
+		return newDockerRuntime(defaultEndpoint(endpoint, DEFAULT_DOCKER_ENDPOINT))
+	case RUNTIME_PODMAN:
+		return newPodmanRuntime(defaultEndpoint(endpoint, DEFAULT_PODMAN_ENDPOINT))
+	case RUNTIME_CRI:
+		return newCRIRuntime(defaultEndpoint(endpoint, DEFAULT_CRI_ENDPOINT)), nil
+	}
+	return nil, fmt.Errorf("%s is not one of the available runtimes which are %v", name, iiapi.RuntimeOptions)
+}
+
+func defaultEndpoint(endpoint, def string) string {
+	if len(endpoint) == 0 {
+		return def
+	}
+	return endpoint
+}
+
+// encodeAuth returns auth encoded as the X-Registry-Auth header, empty when
+// there are no credentials.
+func encodeAuth(auth docker.AuthConfiguration) string {
+	if auth == (docker.AuthConfiguration{}) {
+		return ""
+	}
+	encoded, _ := json.Marshal(auth)
+	return base64.URLEncoding.EncodeToString(encoded)
+}
+
+// writeStatus writes status to output as a docker JSON message.
+func writeStatus(output io.Writer, status string) {
+	encoded, _ := json.Marshal(map[string]string{"status": status})
+	output.Write(append(encoded, '\n'))
+}
+
+// generateRandomName returns the name of a container created by the
+// inspector.
+func generateRandomName() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		return "", fmt.Errorf("Unable to generate random container name: %v\n", err)
+	}
+	return fmt.Sprintf("image-inspector-%016x", n), nil
+}
@@ -0,0 +1,277 @@
+// Package sbom generates the software bill of materials of an extracted
+// image, in the CycloneDX JSON format, from its package databases.
+package sbom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	apiserver "github.com/openshift/image-inspector/pkg/imageserver"
+)
+
+const (
+	BOM_FORMAT   = "CycloneDX"
+	SPEC_VERSION = "1.5"
+	// DPKG_STATUS is the database of the packages installed by dpkg
+	DPKG_STATUS = "/var/lib/dpkg/status"
+	// DPKG_STATUS_DIR holds a status file per package on distroless images
+	DPKG_STATUS_DIR = "/var/lib/dpkg/status.d"
+	// APK_INSTALLED is the database of the packages installed by apk
+	APK_INSTALLED = "/lib/apk/db/installed"
+	// OS_RELEASE is where the distribution of the image is read from
+	OS_RELEASE = "/etc/os-release"
+	// RPM_QUERY_FORMAT is the format of the packages listed by rpm
+	RPM_QUERY_FORMAT = "%{NAME}\t%{EPOCH}:%{VERSION}-%{RELEASE}\t%{ARCH}\n"
+)
+
+// RPM_DB_DIRS are the directories of the rpm database, the newer first.
+var RPM_DB_DIRS = []string{"/usr/lib/sysimage/rpm", "/var/lib/rpm"}
+
+// rpmDBFiles are the files of the rpm databases, sqlite, ndb or bdb.
+var rpmDBFiles = []string{"rpmdb.sqlite", "Packages.db", "Packages"}
+
+// runRPM runs rpm with args and returns its standard output.
+var runRPM = func(args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("rpm", args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// BOM is a CycloneDX bill of materials.
+type BOM struct {
+	BOMFormat   string      `json:"bomFormat"`
+	SpecVersion string      `json:"specVersion"`
+	Version     int         `json:"version"`
+	Metadata    Metadata    `json:"metadata"`
+	Components  []Component `json:"components"`
+}
+
+// Metadata describes the image of the bill of materials.
+type Metadata struct {
+	Timestamp string    `json:"timestamp"`
+	Component Component `json:"component"`
+}
+
+// Component is the image or one of its packages.
+type Component struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	PURL    string `json:"purl,omitempty"`
+}
+
+// pkg is an installed package.
+type pkg struct {
+	kind, name, version, arch string
+}
+
+// Generate returns the bill of materials of the packages installed in root,
+// the extracted filesystem of image, created at created. The dpkg and apk
+// databases are read and the rpm one is queried with rpm. An image without
+// package database has no components.
+func Generate(root, image string, created time.Time) ([]byte, error) {
+	var pkgs []pkg
+	for _, read := range []func(string) ([]pkg, error){readDpkg, readApk, readRpm} {
+		found, err := read(root)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to list the packages of %s: %v\n", image, err)
+		}
+		pkgs = append(pkgs, found...)
+	}
+	distro := distribution(root)
+
+	bom := BOM{
+		BOMFormat:   BOM_FORMAT,
+		SpecVersion: SPEC_VERSION,
+		Version:     1,
+		Metadata: Metadata{
+			Timestamp: created.UTC().Format(time.RFC3339),
+			Component: Component{Type: "container", Name: image},
+		},
+		Components: []Component{},
+	}
+	for _, p := range pkgs {
+		bom.Components = append(bom.Components, Component{
+			Type:    "library",
+			Name:    p.name,
+			Version: p.version,
+			PURL:    purl(p, distro),
+		})
+	}
+	sort.Slice(bom.Components, func(i, j int) bool {
+		return bom.Components[i].PURL < bom.Components[j].PURL
+	})
+	return json.Marshal(bom)
+}
+
+// purl returns the package URL of p installed on distro.
+func purl(p pkg, distro string) string {
+	namespace := ""
+	if len(distro) > 0 {
+		namespace = url.PathEscape(distro) + "/"
+	}
+	s := fmt.Sprintf("pkg:%s/%s%s@%s", p.kind, namespace, url.PathEscape(p.name), url.QueryEscape(p.version))
+	if len(p.arch) > 0 {
+		s += "?arch=" + url.QueryEscape(p.arch)
+	}
+	return s
+}
+
+// open opens name in root, never leaving it.
+func open(root, name string) (*os.File, error) {
+	resolved, err := apiserver.ResolveInRoot(root, name)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(resolved)
+}
+
+// distribution returns the ID of the os-release of root, empty when unknown.
+func distribution(root string) string {
+	file, err := open(root, OS_RELEASE)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if value := strings.TrimPrefix(scanner.Text(), "ID="); value != scanner.Text() {
+			return strings.ToLower(strings.Trim(value, `"'`))
+		}
+	}
+	return ""
+}
+
+// readStanzas calls add with the fields of each stanza of the dpkg or apk
+// database of file, the stanzas being separated by empty lines and the
+// fields by sep.
+func readStanzas(file *os.File, sep string, add func(map[string]string)) error {
+	fields := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(strings.TrimSpace(line)) == 0 {
+			if len(fields) > 0 {
+				add(fields)
+			}
+			fields = map[string]string{}
+			continue
+		}
+		if parts := strings.SplitN(line, sep, 2); len(parts) == 2 && !strings.HasPrefix(line, " ") {
+			fields[parts[0]] = strings.TrimSpace(parts[1])
+		}
+	}
+	if len(fields) > 0 {
+		add(fields)
+	}
+	return scanner.Err()
+}
+
+// readDpkg lists the packages installed by dpkg in root.
+func readDpkg(root string) ([]pkg, error) {
+	files := []string{DPKG_STATUS}
+	if dir, err := apiserver.ResolveInRoot(root, DPKG_STATUS_DIR); err == nil {
+		if infos, err := ioutil.ReadDir(dir); err == nil {
+			for _, info := range infos {
+				if info.Mode().IsRegular() {
+					files = append(files, filepath.Join(DPKG_STATUS_DIR, info.Name()))
+				}
+			}
+		}
+	}
+
+	var pkgs []pkg
+	for _, name := range files {
+		file, err := open(root, name)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		err = readStanzas(file, ":", func(fields map[string]string) {
+			// the status files of distroless images have no Status field
+			if status, ok := fields["Status"]; ok && !strings.HasSuffix(status, " installed") {
+				return
+			}
+			if len(fields["Package"]) > 0 {
+				pkgs = append(pkgs, pkg{"deb", fields["Package"], fields["Version"], fields["Architecture"]})
+			}
+		})
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pkgs, nil
+}
+
+// readApk lists the packages installed by apk in root.
+func readApk(root string) ([]pkg, error) {
+	file, err := open(root, APK_INSTALLED)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var pkgs []pkg
+	err = readStanzas(file, ":", func(fields map[string]string) {
+		if len(fields["P"]) > 0 {
+			pkgs = append(pkgs, pkg{"apk", fields["P"], fields["V"], fields["A"]})
+		}
+	})
+	return pkgs, err
+}
+
+// readRpm lists the packages installed by rpm in root, querying the first
+// rpm database found.
+func readRpm(root string) ([]pkg, error) {
+	for _, dir := range RPM_DB_DIRS {
+		dbPath, err := apiserver.ResolveInRoot(root, dir)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, name := range rpmDBFiles {
+			if info, err := os.Stat(filepath.Join(dbPath, name)); err == nil && info.Mode().IsRegular() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+
+		out, err := runRPM("--dbpath", dbPath, "-qa", "--queryformat", RPM_QUERY_FORMAT)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to query the rpm database: %v", err)
+		}
+		var pkgs []pkg
+		for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+			fields := strings.Split(line, "\t")
+			if len(fields) != 3 || fields[0] == "gpg-pubkey" {
+				continue
+			}
+			version := strings.TrimPrefix(fields[1], "(none):")
+			pkgs = append(pkgs, pkg{"rpm", fields[0], version, fields[2]})
+		}
+		return pkgs, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,143 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const dpkgStatus = `Package: libc6
+Status: install ok installed
+Architecture: amd64
+Version: 2.36-9+deb12u4
+Description: GNU C Library
+ the shared libraries
+
+Package: removed
+Status: deinstall ok config-files
+Architecture: amd64
+Version: 1.0
+`
+
+const apkInstalled = `C:Q1abc=
+P:musl
+V:1.2.4-r2
+A:x86_64
+
+P:busybox
+V:1.36.1-r15
+A:x86_64
+`
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Unable to create the directory of %s: %v", name, err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Unable to write %s: %v", name, err)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	defer func(run func(...string) ([]byte, error)) { runRPM = run }(runRPM)
+
+	for k, v := range map[string]struct {
+		files    map[string]string
+		rpm      string
+		rpmErr   error
+		expected []Component
+		wantErr  bool
+	}{
+		"no package database": {
+			expected: []Component{},
+		},
+		"dpkg": {
+			files: map[string]string{
+				"etc/os-release":             "NAME=\"Debian GNU/Linux\"\nID=debian\n",
+				"var/lib/dpkg/status":        dpkgStatus,
+				"var/lib/dpkg/status.d/base": "Package: base-files\nArchitecture: amd64\nVersion: 12.4\n",
+			},
+			expected: []Component{
+				{Type: "library", Name: "base-files", Version: "12.4", PURL: "pkg:deb/debian/base-files@12.4?arch=amd64"},
+				{Type: "library", Name: "libc6", Version: "2.36-9+deb12u4", PURL: "pkg:deb/debian/libc6@2.36-9%2Bdeb12u4?arch=amd64"},
+			},
+		},
+		"apk": {
+			files: map[string]string{
+				"etc/os-release":       "ID=alpine\n",
+				"lib/apk/db/installed": apkInstalled,
+			},
+			expected: []Component{
+				{Type: "library", Name: "busybox", Version: "1.36.1-r15", PURL: "pkg:apk/alpine/busybox@1.36.1-r15?arch=x86_64"},
+				{Type: "library", Name: "musl", Version: "1.2.4-r2", PURL: "pkg:apk/alpine/musl@1.2.4-r2?arch=x86_64"},
+			},
+		},
+		"rpm": {
+			files: map[string]string{
+				"etc/os-release":           "ID=\"rhel\"\n",
+				"var/lib/rpm/rpmdb.sqlite": "",
+			},
+			rpm: "bash\t(none):5.1.8-9.el9\tx86_64\ngpg-pubkey\t(none):fd431d51-4ae0493b\t(none)\nopenssl\t1:3.0.7-27.el9\tx86_64\n",
+			expected: []Component{
+				{Type: "library", Name: "bash", Version: "5.1.8-9.el9", PURL: "pkg:rpm/rhel/bash@5.1.8-9.el9?arch=x86_64"},
+				{Type: "library", Name: "openssl", Version: "1:3.0.7-27.el9", PURL: "pkg:rpm/rhel/openssl@1%3A3.0.7-27.el9?arch=x86_64"},
+			},
+		},
+		"rpm failure": {
+			files: map[string]string{
+				"usr/lib/sysimage/rpm/rpmdb.sqlite": "",
+			},
+			rpmErr:  fmt.Errorf("exit status 1"),
+			wantErr: true,
+		},
+	} {
+		root, err := ioutil.TempDir("", "sbom-")
+		if err != nil {
+			t.Fatalf("Unable to create root: %v", err)
+		}
+		defer os.RemoveAll(root)
+		writeFiles(t, root, v.files)
+		runRPM = func(args ...string) ([]byte, error) {
+			if len(args) < 2 || !strings.HasPrefix(args[1], root) {
+				t.Errorf("%s: rpm expected to query the database of the image: %v", k, args)
+			}
+			return []byte(v.rpm), v.rpmErr
+		}
+
+		created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		out, err := Generate(root, "example.com/image:1", created)
+		if v.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", k)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", k, err)
+			continue
+		}
+		var bom BOM
+		if err := json.Unmarshal(out, &bom); err != nil {
+			t.Errorf("%s: unable to decode the SBOM: %v", k, err)
+			continue
+		}
+		if bom.BOMFormat != BOM_FORMAT || bom.SpecVersion != SPEC_VERSION {
+			t.Errorf("%s: unexpected format %s %s", k, bom.BOMFormat, bom.SpecVersion)
+		}
+		if bom.Metadata.Timestamp != "2026-01-02T03:04:05Z" || bom.Metadata.Component.Name != "example.com/image:1" {
+			t.Errorf("%s: unexpected metadata %+v", k, bom.Metadata)
+		}
+		if !reflect.DeepEqual(bom.Components, v.expected) {
+			t.Errorf("%s: expected the components %+v but got %+v", k, v.expected, bom.Components)
+		}
+	}
+}
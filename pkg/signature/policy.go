@@ -0,0 +1,116 @@
+package signature
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/openshift/image-inspector/pkg/registry"
+)
+
+const (
+	// REQUIRE_ACCEPT_ANYTHING accepts the images, signed or not
+	REQUIRE_ACCEPT_ANYTHING = "insecureAcceptAnything"
+	// REQUIRE_REJECT rejects all the images
+	REQUIRE_REJECT = "reject"
+	// REQUIRE_SIGNED_BY requires a simple signing signature made with one of
+	// the GPG keys of keyPath, read from the lookaside
+	REQUIRE_SIGNED_BY = "signedBy"
+	// REQUIRE_COSIGN_SIGNED requires a cosign signature made with the public
+	// key of keyPath, read from the lookaside or from the registry
+	REQUIRE_COSIGN_SIGNED = "cosignSigned"
+)
+
+// Requirement is a requirement the signatures of an image must satisfy.
+type Requirement struct {
+	Type string `json:"type"`
+	// KeyPath is the file of the trusted keys
+	KeyPath string `json:"keyPath,omitempty"`
+	// Lookaside is the file:// or http(s):// location of the signatures
+	Lookaside string `json:"lookaside,omitempty"`
+}
+
+// Policy is the policy file of the trusted keys. The images of a scope,
+// which is a registry, a namespace or a repository, must satisfy all the
+// requirements of the most specific scope they belong to, the ones of
+// default when they belong to none.
+type Policy struct {
+	Default []Requirement            `json:"default"`
+	Scopes  map[string][]Requirement `json:"scopes"`
+}
+
+// LoadPolicy reads and validates the policy file fileName.
+func LoadPolicy(fileName string) (*Policy, error) {
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read the signature policy: %v", err)
+	}
+	policy := &Policy{}
+	if err := json.Unmarshal(content, policy); err != nil {
+		return nil, fmt.Errorf("Unable to parse the signature policy %s: %v", fileName, err)
+	}
+	if len(policy.Default) == 0 {
+		return nil, fmt.Errorf("The signature policy %s has no default requirements", fileName)
+	}
+	if err := validateRequirements(policy.Default); err != nil {
+		return nil, fmt.Errorf("Invalid default requirements in %s: %v", fileName, err)
+	}
+	for scope, requirements := range policy.Scopes {
+		if len(requirements) == 0 {
+			return nil, fmt.Errorf("The scope %s of %s has no requirements", scope, fileName)
+		}
+		if err := validateRequirements(requirements); err != nil {
+			return nil, fmt.Errorf("Invalid requirements of %s in %s: %v", scope, fileName, err)
+		}
+	}
+	return policy, nil
+}
+
+func validateRequirements(requirements []Requirement) error {
+	for _, req := range requirements {
+		switch req.Type {
+		case REQUIRE_ACCEPT_ANYTHING, REQUIRE_REJECT:
+			continue
+		case REQUIRE_SIGNED_BY:
+			if len(req.Lookaside) == 0 {
+				return fmt.Errorf("%s requires a lookaside", req.Type)
+			}
+		case REQUIRE_COSIGN_SIGNED:
+		default:
+			return fmt.Errorf("unknown requirement %q", req.Type)
+		}
+		if _, err := os.Stat(req.KeyPath); err != nil {
+			return fmt.Errorf("%s requires a key: %v", req.Type, err)
+		}
+		if len(req.Lookaside) > 0 {
+			lookaside, err := url.Parse(req.Lookaside)
+			if err != nil || (lookaside.Scheme != "file" && lookaside.Scheme != "http" && lookaside.Scheme != "https") {
+				return fmt.Errorf("the lookaside %s is not a file://, http:// or https:// location", req.Lookaside)
+			}
+		}
+	}
+	return nil
+}
+
+// RequirementsFor returns the requirements of the most specific scope of
+// ref, the scope being empty for the default requirements.
+func (p *Policy) RequirementsFor(ref *registry.Reference) (string, []Requirement) {
+	name := ref.Name()
+	scope := ""
+	for candidate := range p.Scopes {
+		candidate = strings.TrimSuffix(candidate, "/")
+		if (name == candidate || strings.HasPrefix(name, candidate+"/")) && len(candidate) > len(scope) {
+			scope = candidate
+		}
+	}
+	if len(scope) == 0 {
+		return "", p.Default
+	}
+	if requirements, ok := p.Scopes[scope]; ok {
+		return scope, requirements
+	}
+	return scope, p.Scopes[scope+"/"]
+}
@@ -0,0 +1,81 @@
+package signature
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/openshift/image-inspector/pkg/registry"
+)
+
+func writePolicy(t *testing.T, dir, name, content string) string {
+	fileName := path.Join(dir, name)
+	if err := ioutil.WriteFile(fileName, []byte(content), 0644); err != nil {
+		t.Fatalf("Unable to write %s: %v", fileName, err)
+	}
+	return fileName
+}
+
+func TestLoadPolicy(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signature-policy-")
+	if err != nil {
+		t.Fatalf("Unable to create the temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for k, v := range map[string]struct {
+		policy     string
+		shouldLoad bool
+	}{
+		"good policy": {policy: `{"default": [{"type": "reject"}], "scopes": {
+			"docker.io/library": [{"type": "signedBy", "keyPath": "test/build.gpg", "lookaside": "file:///var/lib/signatures"}],
+			"quay.io": [{"type": "cosignSigned", "keyPath": "test/build.gpg"}]}}`, shouldLoad: true},
+		"not json":                   {policy: `default: reject`},
+		"no default":                 {policy: `{"scopes": {"quay.io": [{"type": "reject"}]}}`},
+		"unknown requirement":        {policy: `{"default": [{"type": "signedByAnyone"}]}`},
+		"empty scope":                {policy: `{"default": [{"type": "reject"}], "scopes": {"quay.io": []}}`},
+		"no such key":                {policy: `{"default": [{"type": "cosignSigned", "keyPath": "test/nosuchkey.pub"}]}`},
+		"signedBy without lookaside": {policy: `{"default": [{"type": "signedBy", "keyPath": "test/build.gpg"}]}`},
+		"unsupported lookaside": {policy: `{"default": [{"type": "signedBy", "keyPath": "test/build.gpg",
+			"lookaside": "ftp://sigstore.example.com"}]}`},
+	} {
+		_, err := LoadPolicy(writePolicy(t, dir, "policy.json", v.policy))
+		if v.shouldLoad != (err == nil) {
+			t.Errorf("%s: unexpected error %v", k, err)
+		}
+	}
+	if _, err := LoadPolicy(path.Join(dir, "nosuchpolicy.json")); err == nil {
+		t.Errorf("Loading a missing policy should have failed")
+	}
+}
+
+func TestRequirementsFor(t *testing.T) {
+	policy := &Policy{
+		Default: []Requirement{{Type: REQUIRE_REJECT}},
+		Scopes: map[string][]Requirement{
+			"quay.io":                {{Type: REQUIRE_ACCEPT_ANYTHING}},
+			"quay.io/builds/":        {{Type: REQUIRE_SIGNED_BY}},
+			"quay.io/builds/app":     {{Type: REQUIRE_COSIGN_SIGNED}},
+			"docker.io/library/node": {{Type: REQUIRE_ACCEPT_ANYTHING}},
+		},
+	}
+	for image, expected := range map[string]string{
+		"quay.io/other/app":           "quay.io",
+		"quay.io/builds/web":          "quay.io/builds",
+		"quay.io/builds/app@sha256:0": "quay.io/builds/app",
+		"quay.io/builds/application":  "quay.io/builds",
+		"node:8":                      "docker.io/library/node",
+		"nodejs:8":                    "",
+		"registry.example.com/app":    "",
+	} {
+		ref, err := registry.ParseReference(image)
+		if err != nil {
+			t.Fatalf("Unable to parse %s: %v", image, err)
+		}
+		scope, requirements := policy.RequirementsFor(ref)
+		if scope != expected || len(requirements) != 1 {
+			t.Errorf("Expected %s to be in the scope %q but got %q %v", image, expected, scope, requirements)
+		}
+	}
+}
@@ -0,0 +1,417 @@
+package signature
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/registry"
+)
+
+const (
+	GPG = "gpg"
+	// SIMPLE_SIGNING_TYPE is the type of the simple signing payloads
+	SIMPLE_SIGNING_TYPE = "atomic container signature"
+	// COSIGN_SIGNING_TYPE is the type of the cosign payloads
+	COSIGN_SIGNING_TYPE = "cosign container image signature"
+	// COSIGN_SIGNATURE_ANNOTATION holds the signature of the payload layers of
+	// the cosign signature manifests
+	COSIGN_SIGNATURE_ANNOTATION = "dev.cosignproject.cosign/signature"
+	// MAX_SIGNATURES is the most signatures read from a lookaside per image
+	MAX_SIGNATURES     = 16
+	MAX_SIGNATURE_SIZE = 4 * 1024 * 1024
+	LOOKASIDE_TIMEOUT  = 30 * time.Second
+)
+
+// Registry reads the cosign signatures stored in a registry.
+type Registry interface {
+	GetManifest(ref *registry.Reference) (*registry.Manifest, error)
+	GetBlob(ref *registry.Reference, digest string) ([]byte, error)
+}
+
+// Verifier verifies the signatures of the images against a policy.
+type Verifier struct {
+	policy   *Policy
+	registry Registry
+	client   *http.Client
+	// gpg is the binary verifying the simple signing signatures
+	gpg string
+}
+
+// signaturePayload is the signed content of both the simple signing and
+// the cosign signatures.
+type signaturePayload struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// cosignManifest is the manifest of the cosign signatures of an image, one
+// payload layer per signature.
+type cosignManifest struct {
+	Layers []struct {
+		Digest      string            `json:"digest"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"layers"`
+}
+
+// NewVerifier returns a Verifier checking the images against policy, the
+// cosign signatures stored in the registries being read with reg.
+func NewVerifier(policy *Policy, reg Registry) *Verifier {
+	return &Verifier{
+		policy:   policy,
+		registry: reg,
+		client:   &http.Client{Timeout: LOOKASIDE_TIMEOUT},
+		gpg:      GPG,
+	}
+}
+
+// Verify checks the signatures of the image of ref, a reference by digest,
+// against the requirements of its scope in the policy.
+func (v *Verifier) Verify(ref *registry.Reference) *iiapi.SignatureVerification {
+	scope, requirements := v.policy.RequirementsFor(ref)
+	result := &iiapi.SignatureVerification{Scope: scope, Signatures: []iiapi.ImageSignature{}}
+	if len(ref.Digest) == 0 {
+		result.Error = "the digest of the image is unknown"
+		return result
+	}
+	for _, req := range requirements {
+		signatures, err := v.check(req, ref)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		result.Signatures = append(result.Signatures, signatures...)
+	}
+	result.Verified = true
+	return result
+}
+
+// check returns the valid signatures of ref satisfying req, failing when
+// there is none.
+func (v *Verifier) check(req Requirement, ref *registry.Reference) ([]iiapi.ImageSignature, error) {
+	var signatures []iiapi.ImageSignature
+	var err error
+	switch req.Type {
+	case REQUIRE_ACCEPT_ANYTHING:
+		return nil, nil
+	case REQUIRE_REJECT:
+		return nil, fmt.Errorf("the images of %s are rejected", ref.Name())
+	case REQUIRE_SIGNED_BY:
+		signatures, err = v.checkSignedBy(req, ref)
+	case REQUIRE_COSIGN_SIGNED:
+		signatures, err = v.checkCosignSigned(req, ref)
+	default:
+		return nil, fmt.Errorf("unknown requirement %q", req.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(signatures) == 0 {
+		return nil, fmt.Errorf("no signature of %s satisfies %s with %s", ref, req.Type, req.KeyPath)
+	}
+	return signatures, nil
+}
+
+// checkSignedBy returns the simple signing signatures of the lookaside made
+// by one of the keys of req.
+func (v *Verifier) checkSignedBy(req Requirement, ref *registry.Reference) ([]iiapi.ImageSignature, error) {
+	signatures := []iiapi.ImageSignature{}
+	for n := 1; n <= MAX_SIGNATURES; n++ {
+		signature, err := v.readLookaside(req.Lookaside, ref, fmt.Sprintf("signature-%d", n))
+		if err != nil {
+			return nil, err
+		}
+		if signature == nil {
+			break
+		}
+		payload, fingerprint, err := v.verifyGPG(req.KeyPath, signature)
+		if err != nil {
+			log.Printf("WARNING: Ignoring the signature %d of %s: %v", n, ref, err)
+			continue
+		}
+		identity, err := checkPayload(payload, ref, SIMPLE_SIGNING_TYPE)
+		if err != nil {
+			log.Printf("WARNING: Ignoring the signature %d of %s: %v", n, ref, err)
+			continue
+		}
+		signatures = append(signatures, iiapi.ImageSignature{Type: req.Type, Key: fingerprint, Identity: identity})
+	}
+	return signatures, nil
+}
+
+// checkCosignSigned returns the cosign signatures of the lookaside, or of
+// the registry, made by the key of req.
+func (v *Verifier) checkCosignSigned(req Requirement, ref *registry.Reference) ([]iiapi.ImageSignature, error) {
+	key, fingerprint, err := LoadPublicKey(req.KeyPath)
+	if err != nil {
+		return nil, err
+	}
+	var pairs [][2][]byte
+	if len(req.Lookaside) > 0 {
+		pairs, err = v.readCosignLookaside(req.Lookaside, ref)
+	} else {
+		pairs, err = v.readCosignRegistry(ref)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := []iiapi.ImageSignature{}
+	for n, pair := range pairs {
+		payload, signature := pair[0], pair[1]
+		if err := verifyCosign(key, payload, signature); err != nil {
+			log.Printf("WARNING: Ignoring the cosign signature %d of %s: %v", n+1, ref, err)
+			continue
+		}
+		identity, err := checkPayload(payload, ref, COSIGN_SIGNING_TYPE)
+		if err != nil {
+			log.Printf("WARNING: Ignoring the cosign signature %d of %s: %v", n+1, ref, err)
+			continue
+		}
+		signatures = append(signatures, iiapi.ImageSignature{Type: req.Type, Key: fingerprint, Identity: identity})
+	}
+	return signatures, nil
+}
+
+// readCosignLookaside returns the payloads and signatures of the
+// cosign-<n>.payload and cosign-<n>.sig files of the lookaside, as written
+// by cosign generate and cosign sign --output-signature.
+func (v *Verifier) readCosignLookaside(lookaside string, ref *registry.Reference) ([][2][]byte, error) {
+	pairs := [][2][]byte{}
+	for n := 1; n <= MAX_SIGNATURES; n++ {
+		payload, err := v.readLookaside(lookaside, ref, fmt.Sprintf("cosign-%d.payload", n))
+		if err != nil {
+			return nil, err
+		}
+		signature, err := v.readLookaside(lookaside, ref, fmt.Sprintf("cosign-%d.sig", n))
+		if err != nil {
+			return nil, err
+		}
+		if payload == nil || signature == nil {
+			break
+		}
+		pairs = append(pairs, [2][]byte{payload, bytes.TrimSpace(signature)})
+	}
+	return pairs, nil
+}
+
+// readCosignRegistry returns the payloads and signatures of the cosign
+// signature manifest of ref, tagged sha256-<digest>.sig in its repository.
+func (v *Verifier) readCosignRegistry(ref *registry.Reference) ([][2][]byte, error) {
+	sigRef := &registry.Reference{
+		Registry:   ref.Registry,
+		Repository: ref.Repository,
+		Tag:        strings.Replace(ref.Digest, ":", "-", 1) + ".sig",
+	}
+	manifest, err := v.registry.GetManifest(sigRef)
+	if err != nil {
+		log.Printf("WARNING: No cosign signature of %s found: %v", ref, err)
+		return nil, nil
+	}
+	var sigManifest cosignManifest
+	if err := json.Unmarshal(manifest.Content, &sigManifest); err != nil {
+		return nil, fmt.Errorf("Unable to parse the cosign signatures of %s: %v", ref, err)
+	}
+	pairs := [][2][]byte{}
+	for _, layer := range sigManifest.Layers {
+		signature, ok := layer.Annotations[COSIGN_SIGNATURE_ANNOTATION]
+		if !ok {
+			continue
+		}
+		payload, err := v.registry.GetBlob(ref, layer.Digest)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, [2][]byte{payload, []byte(signature)})
+	}
+	return pairs, nil
+}
+
+// readLookaside returns the file name of the signatures of ref in the
+// lookaside, <lookaside>/<repository>@<algorithm>=<digest>/<name>, nil when
+// there is no such file.
+func (v *Verifier) readLookaside(lookaside string, ref *registry.Reference, name string) ([]byte, error) {
+	location, err := url.Parse(lookaside)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid lookaside %s: %v", lookaside, err)
+	}
+	location.Path = path.Join(location.Path, ref.Repository+"@"+strings.Replace(ref.Digest, ":", "=", 1), name)
+
+	if location.Scheme == "file" {
+		content, err := ioutil.ReadFile(location.Path)
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return content, err
+	}
+	resp, err := v.client.Get(location.String())
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read the signatures of %s: %v", ref, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Unable to read the signatures of %s: lookaside returned %s", ref, resp.Status)
+	}
+	return ioutil.ReadAll(io.LimitReader(resp.Body, MAX_SIGNATURE_SIZE))
+}
+
+// verifyGPG verifies the simple signing signature with the GPG keys of
+// keyPath, returning the signed payload and the fingerprint of the key
+// that made it.
+func (v *Verifier) verifyGPG(keyPath string, signature []byte) ([]byte, string, error) {
+	home, err := ioutil.TempDir("", "image-inspector-gpg-")
+	if err != nil {
+		return nil, "", fmt.Errorf("Unable to create the gpg home: %v", err)
+	}
+	defer os.RemoveAll(home)
+	gpg := func(args ...string) error {
+		var stderr bytes.Buffer
+		cmd := exec.Command(v.gpg, append([]string{"--batch", "--no-tty", "--homedir", home}, args...)...)
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("gpg %s failed: %v: %s", args[len(args)-2], err, strings.TrimSpace(stderr.String()))
+		}
+		return nil
+	}
+
+	if err := gpg("--import", keyPath); err != nil {
+		return nil, "", err
+	}
+	signatureFile, statusFile, payloadFile := path.Join(home, "signature"), path.Join(home, "status"), path.Join(home, "payload")
+	if err := ioutil.WriteFile(signatureFile, signature, 0600); err != nil {
+		return nil, "", err
+	}
+	if err := gpg("--status-file", statusFile, "--output", payloadFile, "--decrypt", signatureFile); err != nil {
+		return nil, "", err
+	}
+	status, err := ioutil.ReadFile(statusFile)
+	if err != nil {
+		return nil, "", err
+	}
+	fingerprint := ""
+	for _, line := range strings.Split(string(status), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "[GNUPG:]" {
+			continue
+		}
+		switch fields[1] {
+		case "VALIDSIG":
+			if len(fields) > 2 {
+				fingerprint = fields[2]
+			}
+		case "BADSIG", "ERRSIG", "EXPKEYSIG", "REVKEYSIG":
+			return nil, "", fmt.Errorf("gpg reported %s", strings.Join(fields[1:], " "))
+		}
+	}
+	if len(fingerprint) == 0 {
+		return nil, "", fmt.Errorf("gpg reported no valid signature")
+	}
+	payload, err := ioutil.ReadFile(payloadFile)
+	return payload, fingerprint, err
+}
+
+// LoadPublicKey reads the PEM public key of keyPath, returning it with its
+// SHA256 fingerprint.
+func LoadPublicKey(keyPath string) (crypto.PublicKey, string, error) {
+	content, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		return nil, "", fmt.Errorf("Unable to read the public key: %v", err)
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, "", fmt.Errorf("%s is not a PEM public key", keyPath)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, "", fmt.Errorf("Unable to parse the public key %s: %v", keyPath, err)
+	}
+	sum := sha256.Sum256(block.Bytes)
+	return key, "SHA256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// verifyCosign verifies the base64 signature of payload with key.
+func verifyCosign(key crypto.PublicKey, payload, signature []byte) error {
+	raw, err := base64.StdEncoding.DecodeString(string(signature))
+	if err != nil {
+		return fmt.Errorf("the signature is not base64: %v", err)
+	}
+	return VerifyBlob(key, payload, raw)
+}
+
+// VerifyBlob verifies the raw signature of payload made with the private key
+// of key: an ECDSA or RSA PKCS #1 v1.5 signature of its SHA-256 digest or an
+// ed25519 signature of payload itself.
+func VerifyBlob(key crypto.PublicKey, payload, raw []byte) error {
+	digest := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, digest[:], raw) {
+			return fmt.Errorf("invalid signature")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], raw); err != nil {
+			return fmt.Errorf("invalid signature: %v", err)
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, payload, raw) {
+			return fmt.Errorf("invalid signature")
+		}
+	default:
+		return fmt.Errorf("unsupported key type %T", key)
+	}
+	return nil
+}
+
+// checkPayload checks the signed payload is of signatureType and is for
+// the manifest of ref in its repository, returning the signed identity.
+func checkPayload(content []byte, ref *registry.Reference, signatureType string) (string, error) {
+	var payload signaturePayload
+	if err := json.Unmarshal(content, &payload); err != nil {
+		return "", fmt.Errorf("unable to parse the signed payload: %v", err)
+	}
+	critical := payload.Critical
+	if critical.Type != signatureType {
+		return "", fmt.Errorf("the signature is of type %q instead of %q", critical.Type, signatureType)
+	}
+	if critical.Image.DockerManifestDigest != ref.Digest {
+		return "", fmt.Errorf("the signature is for the manifest %s", critical.Image.DockerManifestDigest)
+	}
+	identity, err := registry.ParseReference(critical.Identity.DockerReference)
+	if err != nil {
+		return "", fmt.Errorf("the signature has an invalid identity: %v", err)
+	}
+	if identity.Name() != ref.Name() {
+		return "", fmt.Errorf("the signature is for %s", critical.Identity.DockerReference)
+	}
+	return critical.Identity.DockerReference, nil
+}
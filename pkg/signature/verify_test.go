@@ -0,0 +1,194 @@
+package signature
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"testing"
+
+	"github.com/openshift/image-inspector/pkg/registry"
+)
+
+const (
+	// testDigest is the digest of the manifest signed in test/sigstore
+	testDigest = "sha256:05b3abf2579a5eb66403cd78be557fd860633a1fe2103c7642030defe32c657f"
+	// testFingerprint is the fingerprint of test/build.gpg
+	testFingerprint = "14802990483C8164435CA20E8550C695DAB23988"
+)
+
+func mustParseReference(t *testing.T, image string) *registry.Reference {
+	ref, err := registry.ParseReference(image)
+	if err != nil {
+		t.Fatalf("Unable to parse %s: %v", image, err)
+	}
+	return ref
+}
+
+func TestVerifySignedBy(t *testing.T) {
+	if _, err := exec.LookPath(GPG); err != nil {
+		t.Skipf("gpg is not available: %v", err)
+	}
+	wd, _ := os.Getwd()
+	lookaside := "file://" + path.Join(wd, "test/sigstore")
+
+	for k, v := range map[string]struct {
+		image    string
+		keyPath  string
+		verified bool
+	}{
+		"signed image":             {image: "fedora@" + testDigest, keyPath: "test/build.gpg", verified: true},
+		"signed by another key":    {image: "fedora@" + testDigest, keyPath: "test/other.gpg"},
+		"signed for another image": {image: "centos@" + testDigest, keyPath: "test/build.gpg"},
+		"unsigned image":           {image: "debian@" + testDigest, keyPath: "test/build.gpg"},
+	} {
+		policy := &Policy{Default: []Requirement{{Type: REQUIRE_SIGNED_BY, KeyPath: v.keyPath, Lookaside: lookaside}}}
+		result := NewVerifier(policy, nil).Verify(mustParseReference(t, v.image))
+		if result.Verified != v.verified {
+			t.Errorf("%s: expected the verification to be %t but got %+v", k, v.verified, result)
+			continue
+		}
+		if v.verified && (len(result.Signatures) != 1 || result.Signatures[0].Key != testFingerprint ||
+			result.Signatures[0].Identity != "docker.io/library/fedora:26") {
+			t.Errorf("%s: unexpected signatures %+v", k, result.Signatures)
+		}
+		if !v.verified && len(result.Error) == 0 {
+			t.Errorf("%s: the reason of the failure should have been recorded", k)
+		}
+	}
+}
+
+// fakeRegistry serves the cosign signature manifest of one image.
+type fakeRegistry struct {
+	manifests map[string][]byte
+	blobs     map[string][]byte
+}
+
+func (r *fakeRegistry) GetManifest(ref *registry.Reference) (*registry.Manifest, error) {
+	content, ok := r.manifests[ref.String()]
+	if !ok {
+		return nil, fmt.Errorf("registry returned 404 Not Found")
+	}
+	return &registry.Manifest{MediaType: registry.MEDIA_TYPE_OCI_MANIFEST, Content: content}, nil
+}
+
+func (r *fakeRegistry) GetBlob(ref *registry.Reference, digest string) ([]byte, error) {
+	content, ok := r.blobs[digest]
+	if !ok {
+		return nil, fmt.Errorf("registry returned 404 Not Found")
+	}
+	return content, nil
+}
+
+// newCosignKey writes the public key of a new ECDSA key in dir and returns
+// the key.
+func newCosignKey(t *testing.T, dir, name string) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Unable to generate a key: %v", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatalf("Unable to marshal the public key: %v", err)
+	}
+	if err := ioutil.WriteFile(path.Join(dir, name), pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0644); err != nil {
+		t.Fatalf("Unable to write the public key: %v", err)
+	}
+	return key
+}
+
+// cosignSign returns the cosign payload of identity and digest and its
+// base64 signature made with key.
+func cosignSign(t *testing.T, key *ecdsa.PrivateKey, identity, digest string) ([]byte, string) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"%s"},"image":{"docker-manifest-digest":"%s"},"type":"%s"},"optional":null}`,
+		identity, digest, COSIGN_SIGNING_TYPE))
+	sum := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, sum[:])
+	if err != nil {
+		t.Fatalf("Unable to sign: %v", err)
+	}
+	return payload, base64.StdEncoding.EncodeToString(signature)
+}
+
+func TestVerifyCosignSigned(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cosign-")
+	if err != nil {
+		t.Fatalf("Unable to create the temporary directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	key := newCosignKey(t, dir, "cosign.pub")
+	newCosignKey(t, dir, "other.pub")
+
+	// the signatures of quay.io/builds/app are in the registry
+	payload, signature := cosignSign(t, key, "quay.io/builds/app", testDigest)
+	sum := sha256.Sum256(payload)
+	payloadDigest := "sha256:" + hex.EncodeToString(sum[:])
+	reg := &fakeRegistry{
+		manifests: map[string][]byte{
+			"quay.io/builds/app:" + "sha256-05b3abf2579a5eb66403cd78be557fd860633a1fe2103c7642030defe32c657f.sig": []byte(fmt.Sprintf(
+				`{"layers": [{"digest": "%s", "annotations": {"%s": "%s"}}]}`, payloadDigest, COSIGN_SIGNATURE_ANNOTATION, signature)),
+		},
+		blobs: map[string][]byte{payloadDigest: payload},
+	}
+
+	// the signatures of quay.io/builds/web are in a lookaside
+	sigDir := path.Join(dir, "sigstore", "builds/web@"+"sha256=05b3abf2579a5eb66403cd78be557fd860633a1fe2103c7642030defe32c657f")
+	if err := os.MkdirAll(sigDir, 0755); err != nil {
+		t.Fatalf("Unable to create the lookaside: %v", err)
+	}
+	payload, signature = cosignSign(t, key, "quay.io/builds/web:1.0", testDigest)
+	ioutil.WriteFile(path.Join(sigDir, "cosign-1.payload"), payload, 0644)
+	ioutil.WriteFile(path.Join(sigDir, "cosign-1.sig"), []byte(signature+"\n"), 0644)
+
+	for k, v := range map[string]struct {
+		image     string
+		keyPath   string
+		lookaside string
+		verified  bool
+	}{
+		"signed in the registry":        {image: "quay.io/builds/app@" + testDigest, keyPath: "cosign.pub", verified: true},
+		"signed in the lookaside":       {image: "quay.io/builds/web@" + testDigest, keyPath: "cosign.pub", lookaside: "file://" + path.Join(dir, "sigstore"), verified: true},
+		"signed by another key":         {image: "quay.io/builds/app@" + testDigest, keyPath: "other.pub"},
+		"signature of another manifest": {image: "quay.io/builds/app@sha256:1234", keyPath: "cosign.pub"},
+		"unsigned image":                {image: "quay.io/builds/web@" + testDigest, keyPath: "cosign.pub"},
+	} {
+		policy := &Policy{
+			Default: []Requirement{{Type: REQUIRE_REJECT}},
+			Scopes: map[string][]Requirement{
+				"quay.io/builds": {{Type: REQUIRE_COSIGN_SIGNED, KeyPath: path.Join(dir, v.keyPath), Lookaside: v.lookaside}},
+			},
+		}
+		result := NewVerifier(policy, reg).Verify(mustParseReference(t, v.image))
+		if result.Verified != v.verified || result.Scope != "quay.io/builds" {
+			t.Errorf("%s: expected the verification to be %t but got %+v", k, v.verified, result)
+			continue
+		}
+		if v.verified && (len(result.Signatures) != 1 || result.Signatures[0].Type != REQUIRE_COSIGN_SIGNED) {
+			t.Errorf("%s: unexpected signatures %+v", k, result.Signatures)
+		}
+	}
+}
+
+func TestVerifyDefault(t *testing.T) {
+	ref := mustParseReference(t, "fedora@"+testDigest)
+	accept := &Policy{Default: []Requirement{{Type: REQUIRE_ACCEPT_ANYTHING}}}
+	if result := NewVerifier(accept, nil).Verify(ref); !result.Verified || result.Scope != "" {
+		t.Errorf("Expected the image to be accepted but got %+v", result)
+	}
+	reject := &Policy{Default: []Requirement{{Type: REQUIRE_REJECT}}}
+	if result := NewVerifier(reject, nil).Verify(ref); result.Verified {
+		t.Errorf("Expected the image to be rejected but got %+v", result)
+	}
+	if result := NewVerifier(accept, nil).Verify(mustParseReference(t, "fedora:26")); result.Verified {
+		t.Errorf("An image without digest can't be verified but got %+v", result)
+	}
+}
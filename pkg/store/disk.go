@@ -0,0 +1,102 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"strings"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+const (
+	// RESULT_FILE_SUFFIX is the suffix of the files holding the results
+	RESULT_FILE_SUFFIX = ".json"
+)
+
+// diskStore is a ResultStore keeping each result in a JSON file of a
+// directory. All the results are indexed in memory when it's opened.
+type diskStore struct {
+	memoryStore
+	dir string
+}
+
+// ensures this always implements the interface or fail compilation.
+var _ ResultStore = &diskStore{}
+
+// NewDiskStore opens the results store in dir, creating it if needed.
+func NewDiskStore(dir string) (ResultStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("Unable to create the results store: %v\n", err)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to open the results store: %v\n", err)
+	}
+
+	s := &diskStore{dir: dir}
+	for _, fi := range files {
+		if fi.IsDir() || !strings.HasSuffix(fi.Name(), RESULT_FILE_SUFFIX) {
+			continue
+		}
+		result, err := readResult(path.Join(dir, fi.Name()))
+		if err != nil {
+			log.Printf("WARNING: Skipping result %s: %v", fi.Name(), err)
+			continue
+		}
+		s.add(result)
+	}
+	return s, nil
+}
+
+func readResult(filename string) (*iiapi.ScanResult, error) {
+	body, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	result := &iiapi.ScanResult{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return nil, err
+	}
+	if len(result.ID) == 0 || len(result.ImageDigest) == 0 {
+		return nil, fmt.Errorf("incomplete result")
+	}
+	return result, nil
+}
+
+func (s *diskStore) Put(result *iiapi.ScanResult) error {
+	if err := prepareResult(result); err != nil {
+		return err
+	}
+	body, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("Unable to serialize the result: %v\n", err)
+	}
+
+	// the result is renamed in place once complete, so that a crash never
+	// leaves partial results behind
+	tmp, err := ioutil.TempFile(s.dir, ".result-")
+	if err != nil {
+		return fmt.Errorf("Unable to store the result: %v\n", err)
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(body)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path.Join(s.dir, result.ID+RESULT_FILE_SUFFIX))
+	}
+	if err != nil {
+		return fmt.Errorf("Unable to store the result: %v\n", err)
+	}
+
+	s.add(result)
+	return nil
+}
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+// memoryStore is a ResultStore keeping the results in memory only.
+type memoryStore struct {
+	lock sync.RWMutex
+	// results are sorted by finish time, the oldest first
+	results []*iiapi.ScanResult
+}
+
+// ensures this always implements the interface or fail compilation.
+var _ ResultStore = &memoryStore{}
+
+// NewMemoryStore returns a ResultStore whose results are lost on exit.
+func NewMemoryStore() ResultStore {
+	return &memoryStore{}
+}
+
+func (s *memoryStore) Put(result *iiapi.ScanResult) error {
+	if err := prepareResult(result); err != nil {
+		return err
+	}
+	s.add(result)
+	return nil
+}
+
+// prepareResult checks that result can be stored and sets its ID.
+func prepareResult(result *iiapi.ScanResult) error {
+	if len(result.ImageDigest) == 0 {
+		return fmt.Errorf("Unable to store a result without the digest of the image manifest")
+	}
+	if len(result.ID) == 0 {
+		id, err := generateResultID()
+		if err != nil {
+			return err
+		}
+		result.ID = id
+	}
+	if strings.ContainsAny(result.ID, "/\\") || strings.HasPrefix(result.ID, ".") {
+		return fmt.Errorf("Invalid result id %q", result.ID)
+	}
+	return nil
+}
+
+// add inserts result keeping the results sorted.
+func (s *memoryStore) add(result *iiapi.ScanResult) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	idx := sort.Search(len(s.results), func(i int) bool {
+		return s.results[i].Finished.After(result.Finished)
+	})
+	s.results = append(s.results, nil)
+	copy(s.results[idx+1:], s.results[idx:])
+	s.results[idx] = result
+}
+
+func (s *memoryStore) Latest(image string) (*iiapi.ScanResult, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	for idx := len(s.results) - 1; idx >= 0; idx-- {
+		if matchesImage(s.results[idx], image) {
+			result := *s.results[idx]
+			return &result, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *memoryStore) History(image string) ([]iiapi.ScanResult, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	history := []iiapi.ScanResult{}
+	for idx := len(s.results) - 1; idx >= 0; idx-- {
+		if matchesImage(s.results[idx], image) {
+			history = append(history, *s.results[idx])
+		}
+	}
+	return history, nil
+}
+
+func (s *memoryStore) AffectedBy(cve string) ([]iiapi.ScanResult, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	seen := map[string]bool{}
+	affected := []iiapi.ScanResult{}
+	for idx := len(s.results) - 1; idx >= 0; idx-- {
+		result := s.results[idx]
+		if seen[result.ImageDigest] {
+			continue
+		}
+		seen[result.ImageDigest] = true
+		if isAffectedBy(result, cve) {
+			affected = append(affected, *result)
+		}
+	}
+	return affected, nil
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+// ErrNotFound is returned when there is no result for the requested image.
+var ErrNotFound = fmt.Errorf("No scan result found")
+
+// ResultStore keeps the results of the inspections keyed by image digest.
+type ResultStore interface {
+	// Put records result, setting its ID when it is empty.
+	Put(result *iiapi.ScanResult) error
+	// Latest returns the most recent result for image, which is either a
+	// digest, an image id or a reference of the image.
+	Latest(image string) (*iiapi.ScanResult, error)
+	// History returns all the results for image, the most recent first.
+	History(image string) ([]iiapi.ScanResult, error)
+	// AffectedBy returns the latest result of each image digest having a
+	// failed finding that references cve.
+	AffectedBy(cve string) ([]iiapi.ScanResult, error)
+}
+
+// NewScanResult returns the result to store for the inspection of report.
+func NewScanResult(report *iiapi.InspectorReport, scanTypes []string, started, finished time.Time) *iiapi.ScanResult {
+	result := &iiapi.ScanResult{
+		Image:     report.Image,
+		ScanTypes: scanTypes,
+		Started:   started,
+		Finished:  finished,
+		Metadata:  report.Metadata,
+		Findings:  report.Findings,
+		SBOM:      report.SBOM,
+		// the attestation is served along with the reused results
+		Attestation: report.Attestation,
+	}
+	if report.Metadata != nil {
+		_, result.ImageDigest = report.Metadata.RepoDigest()
+		result.ImageID = report.Metadata.ID
+	}
+	if result.ScanTypes == nil {
+		result.ScanTypes = []string{}
+	}
+	return result
+}
+
+// IsDigestReference returns true when image can only refer to a single
+// image content, i.e. it's a digest or a reference by digest.
+func IsDigestReference(image string) bool {
+	return strings.Contains(image, "@") || strings.HasPrefix(image, "sha256:")
+}
+
+// NormalizeReference adds the implicit latest tag to image references
+// without tag or digest.
+func NormalizeReference(image string) string {
+	if IsDigestReference(image) {
+		return image
+	}
+	if strings.Contains(image[strings.LastIndex(image, "/")+1:], ":") {
+		return image
+	}
+	return image + ":latest"
+}
+
+// matchesImage returns true if result is a result for image.
+func matchesImage(result *iiapi.ScanResult, image string) bool {
+	if image == result.ImageDigest || image == result.ImageID {
+		return true
+	}
+	image = NormalizeReference(image)
+	if image == NormalizeReference(result.Image) {
+		return true
+	}
+	if result.Metadata == nil {
+		return false
+	}
+	if provenance := result.Metadata.Provenance; provenance != nil &&
+		(image == NormalizeReference(provenance.Reference) || image == provenance.RepoDigest) {
+		return true
+	}
+	for _, ref := range append(result.Metadata.RepoTags, result.Metadata.RepoDigests...) {
+		if image == NormalizeReference(ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAffectedBy returns true if result has a failed finding referencing cve.
+func isAffectedBy(result *iiapi.ScanResult, cve string) bool {
+	for _, finding := range result.Findings {
+		if finding.Result != iiapi.ResultFail {
+			continue
+		}
+		if strings.EqualFold(finding.ID, cve) {
+			return true
+		}
+		for _, ref := range finding.References {
+			if strings.EqualFold(ref, cve) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func generateResultID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("Unable to generate result id: %v\n", err)
+	}
+	return hex.EncodeToString(id), nil
+}
@@ -0,0 +1,167 @@
+package store
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	docker "github.com/fsouza/go-dockerclient"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+)
+
+func newTestResult(image, digest string, finished time.Time, cves ...string) *iiapi.ScanResult {
+	meta := &iiapi.InspectorMetadata{}
+	// the id of the image is the digest of its configuration
+	meta.ID = strings.Replace(digest, "sha256:", "sha256:c0", 1)
+	meta.RepoTags = []string{image}
+	meta.RepoDigests = []string{"docker.io/library/fedora@" + digest}
+	report := &iiapi.InspectorReport{Image: image, Metadata: meta, SBOM: json.RawMessage(`{"bomFormat":"CycloneDX"}`)}
+	for _, cve := range cves {
+		report.Findings = append(report.Findings, iiapi.Finding{
+			Scanner:    "OpenSCAP",
+			ID:         "oval:" + cve,
+			Result:     iiapi.ResultFail,
+			References: []string{cve},
+		})
+	}
+	return NewScanResult(report, []string{"openscap"}, finished.Add(-time.Minute), finished)
+}
+
+func testResultStore(t *testing.T, s ResultStore) {
+	now := time.Now()
+	// debian:9 was resolved to its digest before being pulled
+	pinned := newTestResult("docker.io/library/debian@sha256:dddd", "sha256:dddd", now)
+	pinned.Metadata.Provenance = &iiapi.ImageProvenance{Reference: "debian:9"}
+	for _, result := range []*iiapi.ScanResult{
+		newTestResult("fedora:22", "sha256:aaaa", now.Add(-3*time.Hour), "CVE-2016-0001", "CVE-2016-0002"),
+		newTestResult("fedora:22", "sha256:bbbb", now.Add(-1*time.Hour), "CVE-2016-0002"),
+		newTestResult("fedora:22", "sha256:aaaa", now.Add(-2*time.Hour), "CVE-2016-0001"),
+		newTestResult("fedora", "sha256:cccc", now, "CVE-2016-0001"),
+		pinned,
+	} {
+		if err := s.Put(result); err != nil {
+			t.Fatalf("Unable to store a result: %v", err)
+		}
+		if len(result.ID) == 0 {
+			t.Errorf("The stored result should have been given an id")
+		}
+	}
+	if err := s.Put(&iiapi.ScanResult{}); err == nil {
+		t.Errorf("Storing a result without digest should have failed")
+	}
+	if err := s.Put(NewScanResult(&iiapi.InspectorReport{Image: "local", Metadata: &iiapi.InspectorMetadata{Image: docker.Image{ID: "sha256:eeee"}}},
+		nil, now, now)); err == nil {
+		t.Errorf("Storing the result of an image without manifest digest should have failed")
+	}
+
+	for image, digest := range map[string]string{
+		"fedora:22":                            "sha256:bbbb",
+		"sha256:aaaa":                          "sha256:aaaa",
+		"fedora":                               "sha256:cccc",
+		"fedora:latest":                        "sha256:cccc",
+		"sha256:c0bbbb":                        "sha256:bbbb",
+		"debian:9":                             "sha256:dddd",
+		"docker.io/library/debian@sha256:dddd": "sha256:dddd",
+	} {
+		result, err := s.Latest(image)
+		if err != nil {
+			t.Errorf("%s should have a latest result: %v", image, err)
+			continue
+		}
+		if result.ImageDigest != digest {
+			t.Errorf("The latest result of %s should be for %s but is for %s", image, digest, result.ImageDigest)
+		}
+		if string(result.Report().SBOM) != `{"bomFormat":"CycloneDX"}` {
+			t.Errorf("The SBOM of %s should have been kept but got %s", image, result.SBOM)
+		}
+	}
+	if _, err := s.Latest("centos:7"); err != ErrNotFound {
+		t.Errorf("centos:7 should not have results but got %v", err)
+	}
+
+	history, err := s.History("fedora:22")
+	if err != nil || len(history) != 3 {
+		t.Fatalf("fedora:22 should have 3 results but got %d: %v", len(history), err)
+	}
+	for idx := 1; idx < len(history); idx++ {
+		if history[idx].Finished.After(history[idx-1].Finished) {
+			t.Errorf("The history should be sorted the most recent first")
+		}
+	}
+
+	for cve, digests := range map[string][]string{
+		// sha256:aaaa is only affected by an older scan
+		"CVE-2016-0002": {"sha256:bbbb"},
+		"cve-2016-0001": {"sha256:cccc", "sha256:aaaa"},
+		"CVE-2016-0003": {},
+	} {
+		affected, err := s.AffectedBy(cve)
+		if err != nil {
+			t.Errorf("Unable to find the images affected by %s: %v", cve, err)
+			continue
+		}
+		if len(affected) != len(digests) {
+			t.Errorf("%s should affect %v but affects %d images", cve, digests, len(affected))
+			continue
+		}
+		for idx := range digests {
+			if affected[idx].ImageDigest != digests[idx] {
+				t.Errorf("%s should affect %v but affects %s", cve, digests, affected[idx].ImageDigest)
+			}
+		}
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	testResultStore(t, NewMemoryStore())
+}
+
+func TestDiskStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "results-store-")
+	if err != nil {
+		t.Fatalf("Unable to create the store directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := NewDiskStore(path.Join(dir, "store"))
+	if err != nil {
+		t.Fatalf("Unable to create the store: %v", err)
+	}
+	testResultStore(t, s)
+
+	// the results must survive reopening the store, skipping broken files
+	if err := ioutil.WriteFile(path.Join(dir, "store", "broken.json"), []byte("{"), 0600); err != nil {
+		t.Fatalf("Unable to write a broken result: %v", err)
+	}
+	reopened, err := NewDiskStore(path.Join(dir, "store"))
+	if err != nil {
+		t.Fatalf("Unable to reopen the store: %v", err)
+	}
+	history, err := reopened.History("fedora:22")
+	if err != nil || len(history) != 3 {
+		t.Errorf("The reopened store should have 3 results for fedora:22 but got %d: %v", len(history), err)
+	}
+	if err := reopened.Put(&iiapi.ScanResult{ID: "../escape", ImageDigest: "sha256:aaaa"}); err == nil {
+		t.Errorf("A result id with a path should have been refused")
+	}
+}
+
+func TestIsDigestReference(t *testing.T) {
+	for image, expected := range map[string]bool{
+		"fedora":                   false,
+		"fedora:22":                false,
+		"registry:5000/fedora":     false,
+		"fedora@sha256:abcd":       true,
+		"sha256:abcd":              true,
+		"registry:5000/fedora@sha": true,
+	} {
+		if IsDigestReference(image) != expected {
+			t.Errorf("%s expected to be a digest reference: %t", image, expected)
+		}
+	}
+}
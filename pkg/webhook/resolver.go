@@ -0,0 +1,31 @@
+package webhook
+
+import (
+	iicmd "github.com/openshift/image-inspector/pkg/cmd"
+	ii "github.com/openshift/image-inspector/pkg/inspector"
+)
+
+// Resolver resolves an image reference to the reference by digest of the
+// image it currently points to.
+type Resolver interface {
+	Resolve(image string) (string, error)
+}
+
+// registryResolver resolves the images with the manifests of their
+// registries, never pulling them.
+type registryResolver struct {
+	opts iicmd.ImageInspectorOptions
+}
+
+// ensures this always implements the interface or fail compilation.
+var _ Resolver = &registryResolver{}
+
+// NewRegistryResolver returns a Resolver getting the manifests of the images
+// from their registries with the registry credentials of opts.
+func NewRegistryResolver(opts iicmd.ImageInspectorOptions) Resolver {
+	return &registryResolver{opts: opts}
+}
+
+func (r *registryResolver) Resolve(image string) (string, error) {
+	return ii.ResolveDigest(r.opts, image)
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"encoding/json"
+)
+
+// AdmissionReview is the subset of a Kubernetes admission.k8s.io/v1
+// AdmissionReview used by the webhook, the API server sends the request and
+// the webhook answers with the response.
+type AdmissionReview struct {
+	APIVersion string             `json:"apiVersion"`
+	Kind       string             `json:"kind"`
+	Request    *AdmissionRequest  `json:"request,omitempty"`
+	Response   *AdmissionResponse `json:"response,omitempty"`
+}
+
+// GroupVersionKind identifies the kind of the reviewed object.
+type GroupVersionKind struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+// AdmissionRequest describes the operation to admit.
+type AdmissionRequest struct {
+	UID       string           `json:"uid"`
+	Kind      GroupVersionKind `json:"kind"`
+	Namespace string           `json:"namespace,omitempty"`
+	Name      string           `json:"name,omitempty"`
+	// Operation is CREATE, UPDATE, DELETE or CONNECT
+	Operation string `json:"operation"`
+	// Object is the object being admitted and OldObject its previous version
+	// on UPDATE
+	Object    json.RawMessage `json:"object,omitempty"`
+	OldObject json.RawMessage `json:"oldObject,omitempty"`
+}
+
+// AdmissionResponse is the decision of the webhook about a request.
+type AdmissionResponse struct {
+	UID     string  `json:"uid"`
+	Allowed bool    `json:"allowed"`
+	Result  *Status `json:"status,omitempty"`
+	// Warnings are shown to the user even when the request is allowed
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Status explains a denial, its message is shown to the user.
+type Status struct {
+	Code    int32  `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,254 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/kube"
+	"github.com/openshift/image-inspector/pkg/store"
+)
+
+const (
+	ADMISSION_API_VERSION = "admission.k8s.io/v1"
+	ADMISSION_REVIEW_KIND = "AdmissionReview"
+	// SCAN_TYPE is the scan an image needs to be evaluated
+	SCAN_TYPE = "openscap"
+	// TRIGGER_INTERVAL is how long the webhook waits before triggering the
+	// inspection of the same image again
+	TRIGGER_INTERVAL = 10 * time.Minute
+	// MAX_REVIEW_SIZE is the maximum size of an AdmissionReview, a bit more
+	// than the maximum size of a Kubernetes object
+	MAX_REVIEW_SIZE = 4 * 1024 * 1024
+	// MAX_LISTED_FINDINGS is the number of findings listed by a denial
+	MAX_LISTED_FINDINGS = 10
+)
+
+// severityRanks orders the severities of the findings, the unknown ones
+// rank below all of them.
+var severityRanks = map[string]int{
+	"low":       1,
+	"medium":    2,
+	"moderate":  2,
+	"high":      3,
+	"important": 3,
+	"critical":  4,
+}
+
+// Policy decides which pods are admitted.
+type Policy struct {
+	// DenySeverity is the lowest severity of the failed findings denying an
+	// image, one of iiapi.SeverityOptions
+	DenySeverity string
+	// FailOpen admits, with a warning, the pods whose images can't be
+	// evaluated instead of denying them
+	FailOpen bool
+}
+
+// Trigger starts the inspection of an image.
+type Trigger interface {
+	Trigger(image string) error
+}
+
+// Webhook is a validating admission webhook for Pods. The images of the
+// containers are resolved to their digest and evaluated against the latest
+// stored result of their scan, the images never scanned get their
+// inspection triggered and are admitted or denied as the policy says for
+// the images that can't be evaluated.
+type Webhook struct {
+	policy   Policy
+	resolver Resolver
+	results  store.ResultStore
+	trigger  Trigger
+
+	lock sync.Mutex
+	// triggered records when the inspection of each digest was triggered
+	triggered map[string]time.Time
+}
+
+// NewWebhook returns a webhook applying policy to the results of results,
+// resolving the images with resolver and triggering the inspections of the
+// images never scanned with trigger.
+func NewWebhook(policy Policy, resolver Resolver, results store.ResultStore, trigger Trigger) *Webhook {
+	return &Webhook{
+		policy:    policy,
+		resolver:  resolver,
+		results:   results,
+		trigger:   trigger,
+		triggered: map[string]time.Time{},
+	}
+}
+
+func (h *Webhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "Only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var review AdmissionReview
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, MAX_REVIEW_SIZE)).Decode(&review); err != nil {
+		http.Error(w, fmt.Sprintf("Unable to parse the admission review: %v", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "The admission review has no request", http.StatusBadRequest)
+		return
+	}
+	apiVersion := review.APIVersion
+	if len(apiVersion) == 0 {
+		apiVersion = ADMISSION_API_VERSION
+	}
+	body, err := json.Marshal(AdmissionReview{
+		APIVersion: apiVersion,
+		Kind:       ADMISSION_REVIEW_KIND,
+		Response:   h.Review(review.Request),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// Review returns the decision about req. Only the creations and the updates
+// of pods are evaluated, on update only the images that changed.
+func (h *Webhook) Review(req *AdmissionRequest) *AdmissionResponse {
+	response := &AdmissionResponse{UID: req.UID, Allowed: true}
+	if req.Kind.Group != "" || req.Kind.Kind != "Pod" || (req.Operation != "CREATE" && req.Operation != "UPDATE") {
+		return response
+	}
+	var pod kube.Pod
+	if err := json.Unmarshal(req.Object, &pod); err != nil {
+		return deny(response, http.StatusBadRequest, fmt.Sprintf("Unable to parse the pod: %v", err))
+	}
+	previous := map[string]bool{}
+	if req.Operation == "UPDATE" {
+		var oldPod kube.Pod
+		if err := json.Unmarshal(req.OldObject, &oldPod); err == nil {
+			for _, container := range oldPod.Spec.AllContainers() {
+				previous[container.Image] = true
+			}
+		}
+	}
+	if len(pod.Metadata.Namespace) == 0 {
+		pod.Metadata.Namespace = req.Namespace
+	}
+
+	var denials, failures []string
+	for _, container := range pod.Spec.AllContainers() {
+		if previous[container.Image] {
+			continue
+		}
+		denial, err := h.evaluate(container.Image)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("container %s: %v", container.Name, err))
+		} else if len(denial) > 0 {
+			denials = append(denials, fmt.Sprintf("container %s: %s", container.Name, denial))
+		}
+	}
+
+	name := pod.Metadata.DisplayName()
+	if len(denials) > 0 {
+		log.Printf("Denied pod %s: %s", name, strings.Join(denials, "; "))
+		return deny(response, http.StatusForbidden, fmt.Sprintf("image-inspector denied the pod: %s", strings.Join(append(denials, failures...), "; ")))
+	}
+	if len(failures) == 0 {
+		return response
+	}
+	if h.policy.FailOpen {
+		log.Printf("Admitted pod %s without evaluating all its images: %s", name, strings.Join(failures, "; "))
+		for _, failure := range failures {
+			response.Warnings = append(response.Warnings, "image-inspector could not evaluate "+failure)
+		}
+		return response
+	}
+	log.Printf("Denied pod %s: %s", name, strings.Join(failures, "; "))
+	return deny(response, http.StatusForbidden, fmt.Sprintf("image-inspector could not evaluate the images of the pod: %s", strings.Join(failures, "; ")))
+}
+
+// deny turns response into a denial with code and message.
+func deny(response *AdmissionResponse, code int32, message string) *AdmissionResponse {
+	response.Allowed = false
+	response.Result = &Status{Code: code, Message: message}
+	return response
+}
+
+// evaluate returns why image is denied, nothing if it's admitted, or the
+// error preventing its evaluation.
+func (h *Webhook) evaluate(image string) (string, error) {
+	digest, err := h.resolver.Resolve(image)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve image %s: %s", image, strings.TrimSpace(err.Error()))
+	}
+	result, err := h.latestScan(digest)
+	if err == store.ErrNotFound {
+		return "", fmt.Errorf("image %s (%s) has not been scanned yet, %s", image, digest, h.triggerInspection(digest))
+	} else if err != nil {
+		return "", fmt.Errorf("unable to look up the scan of image %s (%s): %v", image, digest, err)
+	}
+	if result.Metadata != nil && result.Metadata.OpenSCAP != nil && result.Metadata.OpenSCAP.Status == iiapi.StatusError {
+		return "", fmt.Errorf("the scan of image %s (%s) failed: %s", image, digest, result.Metadata.OpenSCAP.ErrorMessage)
+	}
+
+	ids := []string{}
+	threshold := severityRanks[strings.ToLower(h.policy.DenySeverity)]
+	for _, finding := range result.Findings {
+		if finding.Result == iiapi.ResultFail && severityRanks[strings.ToLower(finding.Severity)] >= threshold {
+			ids = append(ids, finding.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return "", nil
+	}
+	listed := strings.Join(ids, ", ")
+	if len(ids) > MAX_LISTED_FINDINGS {
+		listed = fmt.Sprintf("%s and %d more", strings.Join(ids[:MAX_LISTED_FINDINGS], ", "), len(ids)-MAX_LISTED_FINDINGS)
+	}
+	return fmt.Sprintf("image %s (%s) has %d failed findings of severity %s or higher: %s",
+		image, digest, len(ids), h.policy.DenySeverity, listed), nil
+}
+
+// latestScan returns the most recent result of digest having the scan the
+// policy needs.
+func (h *Webhook) latestScan(digest string) (*iiapi.ScanResult, error) {
+	history, err := h.results.History(digest)
+	if err != nil {
+		return nil, err
+	}
+	for idx := range history {
+		for _, scanType := range history[idx].ScanTypes {
+			if scanType == SCAN_TYPE {
+				return &history[idx], nil
+			}
+		}
+	}
+	return nil, store.ErrNotFound
+}
+
+// triggerInspection triggers the inspection of digest, unless it was done
+// recently, and returns what happened.
+func (h *Webhook) triggerInspection(digest string) string {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if when, ok := h.triggered[digest]; ok && time.Since(when) < TRIGGER_INTERVAL {
+		return "its inspection is in progress"
+	}
+	if err := h.trigger.Trigger(digest); err != nil {
+		log.Printf("WARNING: Unable to trigger the inspection of %s: %v", digest, err)
+		return fmt.Sprintf("unable to trigger its inspection: %v", err)
+	}
+	for previous, when := range h.triggered {
+		if time.Since(when) >= TRIGGER_INTERVAL {
+			delete(h.triggered, previous)
+		}
+	}
+	h.triggered[digest] = time.Now()
+	log.Printf("Triggered the inspection of %s", digest)
+	return "its inspection was triggered"
+}
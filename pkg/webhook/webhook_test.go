@@ -0,0 +1,339 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	iiapi "github.com/openshift/image-inspector/pkg/api"
+	"github.com/openshift/image-inspector/pkg/store"
+)
+
+const (
+	busyboxDigest  = "docker.io/library/busybox@sha256:1111111111111111111111111111111111111111111111111111111111111111"
+	frontendDigest = "registry.example.com/shop/frontend@sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	updatedDigest  = "registry.example.com/shop/frontend@sha256:bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	proxyDigest    = "registry.example.com/shop/proxy@sha256:3333333333333333333333333333333333333333333333333333333333333333"
+)
+
+// fakeResolver resolves the images of its map, the others fail.
+type fakeResolver struct {
+	digests  map[string]string
+	resolved []string
+}
+
+func (r *fakeResolver) Resolve(image string) (string, error) {
+	r.resolved = append(r.resolved, image)
+	if store.IsDigestReference(image) {
+		return image, nil
+	}
+	if digest, ok := r.digests[image]; ok {
+		return digest, nil
+	}
+	return "", fmt.Errorf("manifest unknown")
+}
+
+// fakeStore serves the results of its map, keyed by digest.
+type fakeStore struct {
+	results map[string][]iiapi.ScanResult
+}
+
+func (s *fakeStore) Put(result *iiapi.ScanResult) error {
+	return fmt.Errorf("read-only")
+}
+
+func (s *fakeStore) Latest(image string) (*iiapi.ScanResult, error) {
+	history, err := s.History(image)
+	if err != nil {
+		return nil, err
+	}
+	return &history[0], nil
+}
+
+func (s *fakeStore) History(image string) ([]iiapi.ScanResult, error) {
+	if history, ok := s.results[image]; ok {
+		return history, nil
+	}
+	return nil, store.ErrNotFound
+}
+
+func (s *fakeStore) AffectedBy(cve string) ([]iiapi.ScanResult, error) {
+	return nil, store.ErrNotFound
+}
+
+// fakeTrigger records the triggered inspections.
+type fakeTrigger struct {
+	err       error
+	triggered []string
+}
+
+func (t *fakeTrigger) Trigger(image string) error {
+	t.triggered = append(t.triggered, image)
+	return t.err
+}
+
+func scanned(findings ...iiapi.Finding) []iiapi.ScanResult {
+	return []iiapi.ScanResult{{
+		ScanTypes: []string{SCAN_TYPE},
+		Metadata:  &iiapi.InspectorMetadata{OpenSCAP: &iiapi.OpenSCAPMetadata{Status: iiapi.StatusSuccess}},
+		Findings:  findings,
+	}}
+}
+
+func failed(id, severity string) iiapi.Finding {
+	return iiapi.Finding{Scanner: "OpenSCAP", ID: id, Severity: severity, Result: iiapi.ResultFail}
+}
+
+func review(t *testing.T, h *Webhook, fixture string) *AdmissionReview {
+	body, err := os.Open("test/" + fixture)
+	if err != nil {
+		t.Fatalf("Unable to open %s: %v", fixture, err)
+	}
+	defer body.Close()
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("POST", "/", body))
+	if w.Code != http.StatusOK {
+		t.Fatalf("%s: expected 200 but got %d: %s", fixture, w.Code, w.Body.String())
+	}
+	var response AdmissionReview
+	if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+		t.Fatalf("%s: unable to parse the response: %v", fixture, err)
+	}
+	if response.APIVersion != ADMISSION_API_VERSION || response.Kind != ADMISSION_REVIEW_KIND || response.Response == nil {
+		t.Fatalf("%s: unexpected review %+v", fixture, response)
+	}
+	return &response
+}
+
+func TestWebhook(t *testing.T) {
+	clean := map[string][]iiapi.ScanResult{
+		busyboxDigest:  scanned(),
+		frontendDigest: scanned(iiapi.Finding{ID: "CVE-2016-0003", Severity: "high", Result: iiapi.ResultPass}),
+		proxyDigest:    scanned(),
+	}
+	with := func(digest string, history []iiapi.ScanResult) map[string][]iiapi.ScanResult {
+		results := map[string][]iiapi.ScanResult{digest: history}
+		for d, h := range clean {
+			if d != digest {
+				results[d] = h
+			}
+		}
+		return results
+	}
+	scanError := scanned()
+	scanError[0].Metadata.OpenSCAP = &iiapi.OpenSCAPMetadata{Status: iiapi.StatusError, ErrorMessage: "oscap crashed"}
+	notScanned := map[string][]iiapi.ScanResult{busyboxDigest: clean[busyboxDigest], frontendDigest: clean[frontendDigest]}
+
+	for k, v := range map[string]struct {
+		fixture    string
+		policy     Policy
+		results    map[string][]iiapi.ScanResult
+		unresolved string
+		allowed    bool
+		// messages must all be part of the denial
+		messages  []string
+		absent    string
+		triggered []string
+		warnings  int
+	}{
+		"clean images": {
+			fixture: "pod-create.json",
+			results: clean,
+			allowed: true,
+		},
+		"vulnerable image": {
+			fixture:  "pod-create.json",
+			results:  with(frontendDigest, scanned(failed("CVE-2016-0001", "High"), failed("CVE-2016-0002", "low"))),
+			messages: []string{"container app", "registry.example.com/shop/frontend:1.0", frontendDigest, "1 failed findings of severity high or higher", "CVE-2016-0001"},
+			absent:   "CVE-2016-0002",
+		},
+		"lower threshold": {
+			fixture:  "pod-create.json",
+			policy:   Policy{DenySeverity: "low"},
+			results:  with(frontendDigest, scanned(failed("CVE-2016-0001", "high"), failed("CVE-2016-0002", "low"))),
+			messages: []string{"2 failed findings of severity low or higher", "CVE-2016-0001, CVE-2016-0002"},
+		},
+		"findings below the threshold": {
+			fixture: "pod-create.json",
+			results: with(frontendDigest, scanned(failed("CVE-2016-0002", "medium"), failed("CVE-2016-0004", ""))),
+			allowed: true,
+		},
+		"vulnerable image fail open": {
+			fixture:  "pod-create.json",
+			policy:   Policy{FailOpen: true},
+			results:  with(frontendDigest, scanned(failed("CVE-2016-0001", "critical"))),
+			messages: []string{"CVE-2016-0001"},
+		},
+		"not scanned": {
+			fixture:   "pod-create.json",
+			results:   notScanned,
+			messages:  []string{"could not evaluate", "container proxy", "has not been scanned yet, its inspection was triggered"},
+			triggered: []string{proxyDigest},
+		},
+		"not scanned fail open": {
+			fixture:   "pod-create.json",
+			policy:    Policy{FailOpen: true},
+			results:   notScanned,
+			allowed:   true,
+			triggered: []string{proxyDigest},
+			warnings:  1,
+		},
+		"not scanned and vulnerable": {
+			fixture:   "pod-create.json",
+			policy:    Policy{FailOpen: true},
+			results:   map[string][]iiapi.ScanResult{busyboxDigest: scanned(failed("CVE-2016-0001", "high"))},
+			messages:  []string{"container setup", "CVE-2016-0001", "container app", "container proxy"},
+			triggered: []string{frontendDigest, proxyDigest},
+		},
+		"scanned without openscap": {
+			fixture:   "pod-create.json",
+			results:   with(proxyDigest, []iiapi.ScanResult{{ScanTypes: []string{}}}),
+			messages:  []string{"has not been scanned yet"},
+			triggered: []string{proxyDigest},
+		},
+		"scan failed": {
+			fixture:  "pod-create.json",
+			results:  with(frontendDigest, scanError),
+			messages: []string{"the scan of image registry.example.com/shop/frontend:1.0", "oscap crashed"},
+		},
+		"unresolvable image": {
+			fixture:    "pod-create.json",
+			results:    clean,
+			unresolved: "busybox",
+			messages:   []string{"container setup: unable to resolve image busybox: manifest unknown"},
+		},
+		"unresolvable image fail open": {
+			fixture:    "pod-create.json",
+			policy:     Policy{FailOpen: true},
+			results:    clean,
+			unresolved: "busybox",
+			allowed:    true,
+			warnings:   1,
+		},
+		"update of unchanged images": {
+			fixture: "pod-update.json",
+			results: map[string][]iiapi.ScanResult{updatedDigest: scanned()},
+			allowed: true,
+		},
+		"update to a vulnerable image": {
+			fixture:  "pod-update.json",
+			results:  map[string][]iiapi.ScanResult{updatedDigest: scanned(failed("CVE-2016-0001", "high"))},
+			messages: []string{"registry.example.com/shop/frontend:1.1", "CVE-2016-0001"},
+			absent:   "legacy",
+		},
+		"not a pod": {
+			fixture: "deployment-create.json",
+			allowed: true,
+		},
+	} {
+		resolver := &fakeResolver{digests: map[string]string{
+			"busybox":                                busyboxDigest,
+			"registry.example.com/shop/frontend:1.0": frontendDigest,
+			"registry.example.com/shop/frontend:1.1": updatedDigest,
+		}}
+		delete(resolver.digests, v.unresolved)
+		trigger := &fakeTrigger{}
+		if len(v.policy.DenySeverity) == 0 {
+			v.policy.DenySeverity = "high"
+		}
+		h := NewWebhook(v.policy, resolver, &fakeStore{results: v.results}, trigger)
+
+		response := review(t, h, v.fixture).Response
+		if response.UID == "" {
+			t.Errorf("%s: the response has no uid", k)
+		}
+		if response.Allowed != v.allowed {
+			t.Errorf("%s: expected allowed %v but got %+v", k, v.allowed, response.Result)
+			continue
+		}
+		if v.allowed && response.Result != nil {
+			t.Errorf("%s: unexpected status %+v", k, response.Result)
+		}
+		if !v.allowed {
+			if response.Result == nil || response.Result.Code != http.StatusForbidden {
+				t.Errorf("%s: expected a forbidden status but got %+v", k, response.Result)
+				continue
+			}
+			for _, message := range v.messages {
+				if !strings.Contains(response.Result.Message, message) {
+					t.Errorf("%s: expected %q in the denial %q", k, message, response.Result.Message)
+				}
+			}
+			if len(v.absent) > 0 && strings.Contains(response.Result.Message, v.absent) {
+				t.Errorf("%s: unexpected %q in the denial %q", k, v.absent, response.Result.Message)
+			}
+		}
+		if len(response.Warnings) != v.warnings {
+			t.Errorf("%s: expected %d warnings but got %v", k, v.warnings, response.Warnings)
+		}
+		if fmt.Sprint(trigger.triggered) != fmt.Sprint(v.triggered) {
+			t.Errorf("%s: expected the inspections %v to be triggered but got %v", k, v.triggered, trigger.triggered)
+		}
+		if v.fixture == "deployment-create.json" && len(resolver.resolved) > 0 {
+			t.Errorf("%s: unexpected resolutions %v", k, resolver.resolved)
+		}
+	}
+}
+
+func TestWebhookTriggersOnce(t *testing.T) {
+	trigger := &fakeTrigger{err: fmt.Errorf("The inspection queue is full")}
+	results := &fakeStore{results: map[string][]iiapi.ScanResult{busyboxDigest: scanned()}}
+	resolver := &fakeResolver{digests: map[string]string{
+		"busybox":                                busyboxDigest,
+		"registry.example.com/shop/frontend:1.0": frontendDigest,
+	}}
+	h := NewWebhook(Policy{DenySeverity: "high"}, resolver, results, trigger)
+
+	response := review(t, h, "pod-create.json").Response
+	if response.Allowed || !strings.Contains(response.Result.Message, "unable to trigger its inspection: The inspection queue is full") {
+		t.Errorf("Expected a denial because of the full queue but got %+v", response.Result)
+	}
+	trigger.err = nil
+	review(t, h, "pod-create.json")
+	response = review(t, h, "pod-create.json").Response
+	if !strings.Contains(response.Result.Message, "its inspection is in progress") {
+		t.Errorf("Expected the inspection to be in progress but got %+v", response.Result)
+	}
+	expected := fmt.Sprint([]string{frontendDigest, proxyDigest, frontendDigest, proxyDigest})
+	if fmt.Sprint(trigger.triggered) != expected {
+		t.Errorf("Expected the inspections %s to be triggered but got %v", expected, trigger.triggered)
+	}
+}
+
+func TestWebhookBadRequests(t *testing.T) {
+	h := NewWebhook(Policy{DenySeverity: "high"}, &fakeResolver{}, &fakeStore{}, &fakeTrigger{})
+	for k, v := range map[string]struct {
+		method string
+		body   string
+		code   int
+	}{
+		"get":            {method: "GET", code: http.StatusMethodNotAllowed},
+		"not json":       {method: "POST", body: "<review/>", code: http.StatusBadRequest},
+		"no request":     {method: "POST", body: `{"apiVersion": "admission.k8s.io/v1", "kind": "AdmissionReview"}`, code: http.StatusBadRequest},
+		"invalid object": {method: "POST", body: `{"request": {"uid": "1", "kind": {"kind": "Pod"}, "operation": "CREATE", "object": []}}`, code: http.StatusOK},
+	} {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(v.method, "/", strings.NewReader(v.body)))
+		if w.Code != v.code {
+			t.Errorf("%s: expected %d but got %d", k, v.code, w.Code)
+			continue
+		}
+		if w.Code != http.StatusOK {
+			continue
+		}
+		body, _ := ioutil.ReadAll(w.Body)
+		var response AdmissionReview
+		if err := json.Unmarshal(body, &response); err != nil || response.Response == nil {
+			t.Errorf("%s: unable to parse the response %s: %v", k, body, err)
+			continue
+		}
+		if response.Response.Allowed || response.Response.Result.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected the pod to be refused but got %+v", k, response.Response)
+		}
+	}
+}